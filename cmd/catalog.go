@@ -0,0 +1,448 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/catalog"
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// newCatalogCommand creates the command to inspect the cached catalogs of conformance images.
+func newCatalogCommand() *cobra.Command {
+	catalogCmd := &cobra.Command{
+		Use:   "catalog",
+		Short: "Inspect the cached tests and images of conformance images.",
+		Long: "Inspect the cached tests and images of conformance images. The catalog of a conformance image is " +
+			"created the first time its tests or images are listed in a cluster, e.g. by --list-tests or --list-images, " +
+			"and reused by subsequent runs. Except for diff, the catalog commands work without a cluster, and offline " +
+			"they fall back to the catalogs created for an image reference.",
+	}
+
+	catalogCmd.AddCommand(newCatalogShowCommand())
+	catalogCmd.AddCommand(newCatalogSearchCommand())
+	catalogCmd.AddCommand(newCatalogClearCommand())
+	catalogCmd.AddCommand(newCatalogDiffCommand())
+
+	return catalogCmd
+}
+
+// newCatalogShowCommand creates the command to show the cached catalogs.
+func newCatalogShowCommand() *cobra.Command {
+	var output string
+
+	showCmd := &cobra.Command{
+		Use:   "show [image]",
+		Short: "Show all cached catalogs or the catalog of a conformance image.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output, types.OutputText, types.OutputJSON, types.OutputYAML); err != nil {
+				return err
+			}
+
+			cache, err := resolvingCache()
+			if err != nil {
+				return err
+			}
+
+			catalogs := []*catalog.Catalog{}
+			if len(args) == 0 {
+				if catalogs, err = cache.List(); err != nil {
+					return err
+				}
+			} else {
+				loaded, err := cache.Load(cmd.Context(), args[0])
+				if err != nil {
+					return err
+				}
+
+				catalogs = append(catalogs, loaded)
+			}
+
+			switch output {
+			case types.OutputJSON:
+				return results.PrintJSON(os.Stdout, catalogs)
+			case types.OutputYAML:
+				return printYAML(os.Stdout, catalogs)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "IMAGE\tTESTS\tIMAGES\tUPDATED")
+			for _, c := range catalogs {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Image, count(c.Specs), count(c.Images), c.Updated.Format(time.RFC3339))
+			}
+
+			return w.Flush()
+		},
+	}
+
+	showCmd.Flags().StringVar(&output, "output", types.OutputText, "format of the catalogs: text, json or yaml (printed to stdout).")
+
+	return showCmd
+}
+
+// newCatalogSearchCommand creates the command to search the cached tests of a conformance image.
+func newCatalogSearchCommand() *cobra.Command {
+	var (
+		image  string
+		skip   string
+		output string
+	)
+
+	searchCmd := &cobra.Command{
+		Use:   "search <focus>",
+		Short: "List the cached tests of a conformance image that a focus and skip expression select.",
+		Long: "List the cached tests of a conformance image whose name matches the focus and does not match the skip " +
+			"expression, without connecting to a cluster. Unlike a run, label filters are not taken into account.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output, types.OutputText, types.OutputJSON, types.OutputYAML); err != nil {
+				return err
+			}
+
+			cache, err := resolvingCache()
+			if err != nil {
+				return err
+			}
+
+			if image == "" {
+				if image, err = onlyCachedImage(cache); err != nil {
+					return err
+				}
+			}
+
+			loaded, err := cache.Load(cmd.Context(), image)
+			if err != nil {
+				return err
+			}
+
+			if loaded.Specs == nil {
+				return fmt.Errorf("no tests cached for %s, run hydrophone --list-tests --conformance-image %s first", image, image)
+			}
+
+			specs, err := catalog.Filter(loaded.Specs, args[0], skip)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("%d of %d tests of %s match.", len(specs), len(loaded.Specs), image)
+
+			return conformance.PrintSpecs(os.Stdout, specs, output)
+		},
+	}
+
+	searchCmd.Flags().StringVar(&image, "conformance-image", "", "conformance image whose tests to search. (default: the only cached image)")
+	searchCmd.Flags().StringVar(&skip, "skip", "", "skip tests matching this regular expression.")
+	searchCmd.Flags().StringVar(&output, "output", types.OutputText, "format of the list of tests: text, json or yaml (printed to stdout).")
+
+	return searchCmd
+}
+
+// newCatalogClearCommand creates the command to remove cached catalogs.
+func newCatalogClearCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear [image]",
+		Short: "Remove the cached catalog of a conformance image, or all cached catalogs.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cache, err := resolvingCache()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				if err := cache.Remove(cmd.Context(), args[0]); err != nil {
+					return err
+				}
+
+				log.Printf("Removed catalog of %s.", args[0])
+
+				return nil
+			}
+
+			if err := cache.Clear(); err != nil {
+				return err
+			}
+
+			log.Printf("Removed all catalogs from %s.", cache.Dir)
+
+			return nil
+		},
+	}
+}
+
+// newCatalogDiffCommand creates the command to compare the catalogs of two conformance images.
+func newCatalogDiffCommand() *cobra.Command {
+	var (
+		fromImage string
+		toImage   string
+		focus     string
+		output    string
+	)
+
+	config := types.NewDefaultConfiguration()
+
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare the tests and test images of two conformance images.",
+		Long: "Compare the tests and test images of two conformance images, e.g. of two Kubernetes versions. " +
+			"Reports added and removed tests as well as added, removed and changed test images. A renamed test " +
+			"is reported as removed and added. Conformance images that are not cataloged yet are listed in the cluster.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateOutput(output, types.OutputText, types.OutputJSON, types.OutputYAML); err != nil {
+				return err
+			}
+
+			cache, err := resolvingCache()
+			if err != nil {
+				return err
+			}
+
+			catalogs := []*catalog.Catalog{}
+			for _, image := range []string{fromImage, toImage} {
+				imageConfig := config
+				imageConfig.ConformanceImage = image
+				lister := catalogLister(imageConfig, nil)
+
+				loaded := &catalog.Catalog{Image: image}
+				if loaded.Specs, err = cache.Specs(cmd.Context(), image, lister, config.StartupTimeout); err != nil {
+					return fmt.Errorf("failed to list tests of %s: %w", image, err)
+				}
+
+				if loaded.Images, err = cache.Images(cmd.Context(), image, lister, config.StartupTimeout); err != nil {
+					return fmt.Errorf("failed to list images of %s: %w", image, err)
+				}
+
+				if loaded.Specs, err = catalog.Filter(loaded.Specs, focus, ""); err != nil {
+					return err
+				}
+
+				catalogs = append(catalogs, loaded)
+			}
+
+			diff := catalog.Compare(catalogs[0], catalogs[1])
+
+			switch output {
+			case types.OutputJSON:
+				return results.PrintJSON(os.Stdout, diff)
+			case types.OutputYAML:
+				return printYAML(os.Stdout, diff)
+			}
+
+			diff.Print()
+
+			return nil
+		},
+	}
+
+	flags := diffCmd.Flags()
+	flags.StringVar(&fromImage, "from-image", "", "conformance image to compare from, e.g. registry.k8s.io/conformance:v1.34.0.")
+	flags.StringVar(&toImage, "to-image", "", "conformance image to compare to, e.g. registry.k8s.io/conformance:v1.35.0.")
+	flags.StringVar(&focus, "focus", `\[Conformance\]`, "only compare tests matching this regular expression.")
+	flags.StringVar(&output, "output", types.OutputText, "format of the comparison: text, json or yaml (printed to stdout).")
+	flags.StringVar(&config.Kubeconfig, "kubeconfig", "", "path to the kubeconfig file used to list the contents of conformance images that are not cataloged yet.")
+	flags.StringVar(&config.BusyboxImage, "busybox-image", config.BusyboxImage, "specify an alternate busybox container image.")
+	flags.DurationVar(&config.StartupTimeout, "startup-timeout", config.StartupTimeout, "max time to wait for the conformance image to list its contents.")
+
+	_ = diffCmd.MarkFlagRequired("from-image")
+	_ = diffCmd.MarkFlagRequired("to-image")
+
+	return diffCmd
+}
+
+// onlyCachedImage returns the image of the only cached catalog.
+func onlyCachedImage(cache *catalog.Cache) (string, error) {
+	catalogs, err := cache.List()
+	if err != nil {
+		return "", err
+	}
+
+	switch len(catalogs) {
+	case 0:
+		return "", errors.New("no catalogs cached, run hydrophone --list-tests first")
+	case 1:
+		return catalogs[0].Image, nil
+	default:
+		return "", fmt.Errorf("%d catalogs cached, please set --conformance-image", len(catalogs))
+	}
+}
+
+// count returns the length of a cached list, or "-" if it was not listed yet.
+func count[T any](list []T) string {
+	if list == nil {
+		return "-"
+	}
+
+	return fmt.Sprint(len(list))
+}
+
+// listTests returns the specs of the configured conformance image that the focus
+// and the configured skip expression select. The specs are taken from the catalog
+// cache if possible, otherwise the conformance image is run in the cluster.
+func listTests(ctx context.Context, config types.Configuration, cluster *cluster, focus string) ([]conformance.Spec, error) {
+	// label filters can only be evaluated by Ginkgo itself
+	if slices.ContainsFunc(config.ExtraGinkgoArgs, func(arg string) bool { return strings.HasPrefix(arg, "--label-filter=") }) {
+		lister := &clusterLister{config: config, cluster: cluster}
+		return lister.ListTests(ctx, conformance.Phase{Focus: focus, Skip: config.Skip}, config.StartupTimeout)
+	}
+
+	cache, err := resolvingCache()
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := cache.Specs(ctx, config.ConformanceImage, catalogLister(config, cluster), config.StartupTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalog.Filter(specs, focus, config.Skip)
+}
+
+// listImages returns the test images used by the configured conformance image,
+// preferably from the catalog cache.
+func listImages(ctx context.Context, config types.Configuration, cluster *cluster) ([]string, error) {
+	cache, err := resolvingCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return cache.Images(ctx, config.ConformanceImage, catalogLister(config, cluster), config.StartupTimeout)
+}
+
+// printYAML writes the value as a YAML document.
+func printYAML(w io.Writer, v any) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	return errors.Join(encoder.Encode(v), encoder.Close())
+}
+
+// resolvingCache returns the default catalog cache, which keys the catalogs by the
+// digests the images currently resolve to.
+func resolvingCache() (*catalog.Cache, error) {
+	cache, err := catalog.DefaultCache()
+	if err != nil {
+		return nil, err
+	}
+
+	client := newRegistryClient()
+	cache.Resolve = func(ctx context.Context, image string) (string, error) {
+		digest, _, err := resolveDigest(ctx, client, image)
+		return digest, err
+	}
+
+	return cache, nil
+}
+
+// listsInCluster returns true if listing the tests or the images of the configured
+// conformance image runs pods in the cluster, because they are not cached.
+func listsInCluster(ctx context.Context, config types.Configuration, tests, images bool) bool {
+	if tests && slices.ContainsFunc(config.ExtraGinkgoArgs, func(arg string) bool { return strings.HasPrefix(arg, "--label-filter=") }) {
+		return true
+	}
+
+	cache, err := resolvingCache()
+	if err != nil {
+		return true
+	}
+
+	cached, err := cache.Lookup(ctx, config.ConformanceImage)
+	if err != nil {
+		return true
+	}
+
+	return (tests && cached.Specs == nil) || (images && cached.Images == nil)
+}
+
+// catalogLister returns a lister for the complete contents of the conformance
+// image, regardless of the filters of the current run. If cluster is nil, the
+// cluster is only connected to when the catalog is not cached yet.
+func catalogLister(config types.Configuration, cluster *cluster) catalog.Lister {
+	config.Skip = ""
+	config.ExtraGinkgoArgs = nil
+
+	return &clusterLister{config: config, cluster: cluster}
+}
+
+// clusterLister lists the contents of a conformance image by running it in the cluster.
+type clusterLister struct {
+	config  types.Configuration
+	cluster *cluster
+}
+
+// ListTests implements catalog.Lister.
+func (l *clusterLister) ListTests(ctx context.Context, phase conformance.Phase, timeout time.Duration) ([]conformance.Spec, error) {
+	testRunner, err := l.testRunner()
+	if err != nil {
+		return nil, err
+	}
+
+	return testRunner.ListTests(ctx, phase, timeout)
+}
+
+// ListImages implements catalog.Lister.
+func (l *clusterLister) ListImages(ctx context.Context, timeout time.Duration) ([]string, error) {
+	testRunner, err := l.testRunner()
+	if err != nil {
+		return nil, err
+	}
+
+	return testRunner.ListImages(ctx, timeout)
+}
+
+// testRunner returns a test runner for the configured conformance image, connecting to the cluster if needed.
+func (l *clusterLister) testRunner() (*conformance.TestRunner, error) {
+	if l.cluster == nil {
+		kubeconfig, err := types.ResolveKubeconfig(l.config.Kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+
+		restConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+		}
+
+		clientset, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error getting config client: %w", err)
+		}
+
+		l.cluster = &cluster{restConfig: restConfig, clientset: clientset}
+	}
+
+	return conformance.NewTestRunner(l.config, l.cluster.restConfig, l.cluster.clientset), nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/catalog"
+	"sigs.k8s.io/hydrophone/pkg/images"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+
+	"github.com/blang/semver/v4"
+)
+
+const (
+	// conformanceRepository is the repository of the official conformance images.
+	conformanceRepository = "registry.k8s.io/conformance"
+
+	// maxCandidates is the number of tags listed when no tag matches the server version.
+	maxCandidates = 10
+)
+
+// defaultConformanceImage returns the conformance image for the server version,
+// looked up in the mirror registry if one is configured. If preferCached is set,
+// a cataloged image whose tag matches the server version exactly is used without
+// asking the registry. If the tags of the repository cannot be listed, the
+// finalized server version is used as tag.
+func defaultConformanceImage(ctx context.Context, serverVersion, mirror string, preferCached bool) (string, error) {
+	repository := conformanceRepository
+	if mirror != "" {
+		repository = images.Rewrite(conformanceRepository, mirror)
+	}
+
+	normalized, err := normalizeVersion(serverVersion)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing server version: %w", err)
+	}
+
+	if preferCached {
+		if cache, err := catalog.DefaultCache(); err == nil {
+			if image := cachedConformanceImage(cache, repository, semver.MustParse(strings.TrimPrefix(serverVersion, "v"))); image != "" {
+				log.Printf("Using cataloged conformance image %s for server version %s.", image, serverVersion)
+				return image, nil
+			}
+		}
+	}
+
+	ref, err := registry.ParseReference(repository)
+	if err != nil {
+		return "", err
+	}
+
+	tags, err := newRegistryClient().Tags(ctx, ref)
+	if err != nil {
+		log.Warnf("Failed to list the tags of %s, assuming %s exists: %v", repository, normalized, err)
+
+		return fmt.Sprintf("%s:%s", repository, normalized), nil
+	}
+
+	tag, reason, err := selectConformanceTag(tags, semver.MustParse(strings.TrimPrefix(serverVersion, "v")))
+	if err != nil {
+		return "", fmt.Errorf("no conformance image in %s matches server version %s: %w", repository, serverVersion, err)
+	}
+
+	log.Printf("Using conformance image tag %s for server version %s: %s.", tag, serverVersion, reason)
+
+	return fmt.Sprintf("%s:%s", repository, tag), nil
+}
+
+// cachedConformanceImage returns the image of a cached catalog in the repository
+// whose tag matches the server version exactly, or an empty string.
+func cachedConformanceImage(cache *catalog.Cache, repository string, ver semver.Version) string {
+	catalogs, err := cache.List()
+	if err != nil {
+		return ""
+	}
+
+	tags := []string{}
+	for _, c := range catalogs {
+		if tag, ok := strings.CutPrefix(c.Image, repository+":"); ok {
+			tags = append(tags, tag)
+		}
+	}
+
+	tag, reason, err := selectConformanceTag(tags, ver)
+	if err != nil || (reason != "exact release" && reason != "exact pre-release") {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%s", repository, tag)
+}
+
+// selectConformanceTag picks the tag of the conformance image that matches the
+// server version best: the exact pre-release, the exact release, or the nearest
+// published patch release of the same minor version, preferring older patches as
+// they do not test features the cluster lacks. Build metadata is ignored. It
+// returns the tag and the reason for choosing it.
+func selectConformanceTag(tags []string, ver semver.Version) (string, string, error) {
+	versions := []semver.Version{}
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag, "v") {
+			continue
+		}
+
+		if v, err := semver.Parse(tag[1:]); err == nil {
+			versions = append(versions, v)
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b semver.Version) int { return a.Compare(b) })
+
+	ver.Build = nil
+	release := semver.Version{Major: ver.Major, Minor: ver.Minor, Patch: ver.Patch}
+
+	if len(ver.Pre) > 0 && slices.ContainsFunc(versions, ver.Equals) {
+		return "v" + ver.String(), "exact pre-release", nil
+	}
+
+	if slices.ContainsFunc(versions, release.Equals) {
+		return "v" + release.String(), "exact release", nil
+	}
+
+	sameMinor := []semver.Version{}
+	for _, v := range versions {
+		if v.Major == ver.Major && v.Minor == ver.Minor {
+			sameMinor = append(sameMinor, v)
+		}
+	}
+
+	var nearest *semver.Version
+	for i, v := range sameMinor {
+		if len(v.Pre) > 0 {
+			continue
+		}
+
+		// the highest older patch wins, otherwise the lowest newer one
+		if nearest == nil || (v.Patch < ver.Patch && v.Patch > nearest.Patch) || (nearest.Patch > ver.Patch && v.Patch < nearest.Patch) {
+			nearest = &sameMinor[i]
+		}
+	}
+
+	if nearest != nil {
+		return "v" + nearest.String(), fmt.Sprintf("nearest published patch release of %d.%d", ver.Major, ver.Minor), nil
+	}
+
+	candidates := sameMinor
+	if len(candidates) == 0 {
+		candidates = versions[max(0, len(versions)-maxCandidates):]
+	}
+
+	if len(candidates) == 0 {
+		return "", "", errors.New("the repository has no versioned tags")
+	}
+
+	names := []string{}
+	for _, v := range candidates {
+		names = append(names, "v"+v.String())
+	}
+
+	return "", "", fmt.Errorf("candidates are %s, use --conformance-image to pick one", strings.Join(names, ", "))
+}
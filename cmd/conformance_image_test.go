@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/catalog"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectConformanceTag(t *testing.T) {
+	tags := []string{"latest", "v1.34.2", "v1.35.0", "v1.35.1", "v1.35.3", "v1.36.0-rc.0", "v1.36.0-rc.1", "sha256-abc.sig"}
+
+	testCases := []struct {
+		name        string
+		version     string
+		expectedTag string
+		expectErr   string
+	}{
+		{
+			name:        "exact release",
+			version:     "1.35.1",
+			expectedTag: "v1.35.1",
+		},
+		{
+			name:        "vendor build metadata",
+			version:     "1.35.3+k3s1",
+			expectedTag: "v1.35.3",
+		},
+		{
+			name:        "exact pre-release",
+			version:     "1.36.0-rc.1",
+			expectedTag: "v1.36.0-rc.1",
+		},
+		{
+			name:        "older patch",
+			version:     "1.35.2",
+			expectedTag: "v1.35.1",
+		},
+		{
+			name:        "unpublished newer patch",
+			version:     "1.35.4+k3s1",
+			expectedTag: "v1.35.3",
+		},
+		{
+			name:        "newer patch",
+			version:     "1.34.1",
+			expectedTag: "v1.34.2",
+		},
+		{
+			name:      "unpublished pre-release",
+			version:   "1.36.0-rc.2",
+			expectErr: "candidates are v1.36.0-rc.0, v1.36.0-rc.1",
+		},
+		{
+			name:      "unpublished minor",
+			version:   "1.37.0-alpha.1",
+			expectErr: "candidates are v1.34.2, v1.35.0, v1.35.1, v1.35.3, v1.36.0-rc.0, v1.36.0-rc.1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tag, _, err := selectConformanceTag(tags, semver.MustParse(tc.version))
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTag, tag)
+		})
+	}
+}
+
+func TestCachedConformanceImage(t *testing.T) {
+	cache := &catalog.Cache{Dir: t.TempDir()}
+
+	for _, image := range []string{"registry.k8s.io/conformance:v1.35.1", "mirror.example.com/k8s/conformance:v1.35.2"} {
+		require.NoError(t, cache.Save(&catalog.Catalog{Image: image}))
+	}
+
+	assert.Equal(t, "registry.k8s.io/conformance:v1.35.1", cachedConformanceImage(cache, conformanceRepository, semver.MustParse("1.35.1+k3s1")))
+	// the registry may have a nearer patch release than the cached one
+	assert.Empty(t, cachedConformanceImage(cache, conformanceRepository, semver.MustParse("1.35.2")))
+	assert.Equal(t, "mirror.example.com/k8s/conformance:v1.35.2", cachedConformanceImage(cache, "mirror.example.com/k8s/conformance", semver.MustParse("1.35.2")))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+// newDiffCommand creates the command to compare the results of two runs.
+func newDiffCommand() *cobra.Command {
+	var (
+		output            string
+		durationThreshold float64
+	)
+
+	diffCmd := &cobra.Command{
+		Use:   "diff <old-dir> <new-dir>",
+		Short: "Compare the results of two runs and report regressions.",
+		Long: "Compare the results of two runs, matching tests by name. Reports newly failing, newly passing, " +
+			"added and removed tests as well as significant duration changes. Exits with code 1 if any test " +
+			"fails that did not fail in the old run.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := validateOutput(output, types.OutputText, types.OutputJSON); err != nil {
+				return err
+			}
+
+			if durationThreshold < 0 {
+				return errors.New("--duration-threshold cannot be negative")
+			}
+
+			oldSummary, err := results.Load(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to load old results: %w", err)
+			}
+
+			newSummary, err := results.Load(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to load new results: %w", err)
+			}
+
+			diff := results.Compare(oldSummary, newSummary, durationThreshold/100)
+
+			if output == types.OutputJSON {
+				if err := results.PrintJSON(os.Stdout, diff); err != nil {
+					return err
+				}
+			} else {
+				diff.Print()
+			}
+
+			if regressions := diff.Regressions(); len(regressions) > 0 {
+				log.Errorf("Found %d regression(s).", len(regressions))
+				os.Exit(1)
+			}
+
+			return nil
+		},
+	}
+
+	diffCmd.Flags().StringVar(&output, "output", types.OutputText, "format of the comparison: text or json (printed to stdout).")
+	diffCmd.Flags().Float64Var(&durationThreshold, "duration-threshold", 50, "minimum change in duration of a test, in percent, to be reported.")
+
+	return diffCmd
+}
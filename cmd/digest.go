@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+	"sigs.k8s.io/hydrophone/pkg/types"
+)
+
+// registryTimeout is the maximum time a registry request may take, including
+// reading its response.
+const registryTimeout = time.Minute
+
+// newRegistryClient returns a client for looking up images, whose requests fail
+// instead of hanging if a registry does not respond.
+func newRegistryClient() *registry.Client {
+	return registry.NewClient(&http.Client{Timeout: registryTimeout})
+}
+
+// pinImages resolves the conformance and busybox images to the digests of their
+// manifests, so that the pods run exactly the images recorded in the results. If
+// an image cannot be resolved it is run by tag, unless --require-digest is set or
+// its signature must be verified.
+func pinImages(ctx context.Context, config *types.Configuration) error {
+	client := newRegistryClient()
+
+	for _, image := range []struct {
+		name      string
+		digest    *string
+		platforms *[]string
+	}{
+		{name: config.ConformanceImage, digest: &config.ConformanceImageDigest, platforms: &config.ConformanceImagePlatformDigests},
+		{name: config.BusyboxImage, digest: &config.BusyboxImageDigest, platforms: &config.BusyboxImagePlatformDigests},
+	} {
+		digest, platforms, err := resolveDigest(ctx, client, image.name)
+		if err != nil {
+			if config.RequireDigest || config.VerifiesSignatures() {
+				return fmt.Errorf("failed to resolve %s to a digest: %w", image.name, err)
+			}
+
+			log.Warnf("Failed to resolve %s to a digest, running it by tag: %v", image.name, err)
+			continue
+		}
+
+		*image.digest, *image.platforms = digest, platforms
+		log.Printf("Pinned %s to digest %s.", image.name, digest)
+	}
+
+	return nil
+}
+
+// resolveDigest returns the digest of an image and, if it is an index, the digests
+// of its platform manifests. If the reference already contains the digest and its
+// manifest cannot be fetched, the platform digests are unknown.
+func resolveDigest(ctx context.Context, client *registry.Client, image string) (string, []string, error) {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return "", nil, err
+	}
+
+	manifest, err := client.Manifest(ctx, ref)
+	if err != nil {
+		if ref.Digest != "" {
+			log.Warnf("Failed to fetch manifest of %s, containers must report its digest exactly: %v", image, err)
+			return ref.Digest, nil, nil
+		}
+
+		return "", nil, err
+	}
+
+	platforms := []string{}
+	for _, desc := range manifest.Manifests {
+		platforms = append(platforms, desc.Digest)
+	}
+
+	return manifest.Digest, platforms, nil
+}
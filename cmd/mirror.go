@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/images"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+// newMirrorCommand creates the command to copy all images needed for a run into a private registry.
+func newMirrorCommand() *cobra.Command {
+	var (
+		mirrorCmd     *cobra.Command
+		config        types.Configuration
+		to            string
+		repoListFile  string
+		plainHTTP     bool
+		username      string
+		passwordStdin bool
+	)
+
+	mirrorCmd = &cobra.Command{
+		Use:   "mirror --to <registry>",
+		Short: "Copy all images needed for a run into a private registry.",
+		Long: "Copy the conformance image, the busybox image and all test images into a private registry, " +
+			"including all architectures of multi-architecture images. The registry host of every image is replaced " +
+			"by --to, keeping the rest of its path. A repo list for --test-repo-list pointing the tests to the mirrored " +
+			"images is written to --repo-list-file.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			effectiveConfig, err := config.Complete(mirrorCmd.Flags())
+			if err != nil {
+				_ = mirrorCmd.Usage()
+				return err
+			}
+
+			// the conformance image defaults to the version of the cluster
+			var connected *cluster
+			if effectiveConfig.ConformanceImage == "" {
+				if connected, effectiveConfig, err = connect(cmd.Context(), effectiveConfig); err != nil {
+					return err
+				}
+			}
+
+			client := registry.NewClient(nil)
+			targetRegistry, _, _ := strings.Cut(to, "/")
+
+			if plainHTTP {
+				client.SetPlainHTTP(targetRegistry)
+			}
+
+			if passwordStdin {
+				password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+				if err != nil && password == "" {
+					return fmt.Errorf("failed to read password: %w", err)
+				}
+
+				client.SetCredentials(targetRegistry, username, strings.TrimSpace(password))
+			}
+
+			testImages, err := listImages(cmd.Context(), *effectiveConfig, connected)
+			if err != nil {
+				return fmt.Errorf("failed to list images: %w", err)
+			}
+
+			mirrorable := []string{}
+			for _, image := range testImages {
+				switch {
+				case image == "" || !images.Pullable(image):
+				case images.RequiresAuth(image):
+					log.Printf("Not mirroring %s, the tests pull it using their own credentials.", image)
+				default:
+					mirrorable = append(mirrorable, image)
+				}
+			}
+
+			all := append([]string{effectiveConfig.ConformanceImage, effectiveConfig.BusyboxImage}, mirrorable...)
+
+			failed := 0
+			for i, image := range all {
+				mirrored := images.Rewrite(image, to)
+				log.Printf("[%d/%d] Copying %s to %s...", i+1, len(all), image, mirrored)
+
+				if err := mirror(cmd.Context(), client, image, mirrored); err != nil {
+					log.Errorf("Failed to copy %s: %v", image, err)
+					failed++
+				}
+			}
+
+			// registries of images that are not mirrored must not be redirected
+			if err := writeRepoList(repoListFile, mirrorable, to); err != nil {
+				return err
+			}
+
+			log.Printf("Wrote repo list to %s. Run the tests using the mirrored images with:", repoListFile)
+			log.Printf("  hydrophone --conformance --conformance-image %s --busybox-image %s --test-repo-list %s",
+				images.Rewrite(effectiveConfig.ConformanceImage, to), images.Rewrite(effectiveConfig.BusyboxImage, to), repoListFile)
+
+			if failed > 0 {
+				return fmt.Errorf("failed to copy %d of %d images", failed, len(all))
+			}
+
+			return nil
+		},
+	}
+
+	config = types.NewDefaultConfiguration()
+	config.AddFlags(mirrorCmd.Flags())
+
+	flags := mirrorCmd.Flags()
+	flags.StringVar(&to, "to", "", "registry prefix to copy the images to, e.g. mirror.example.com:5000/k8s.")
+	flags.StringVar(&repoListFile, "repo-list-file", "repo-list.yaml", "file to write the repo list for --test-repo-list to.")
+	flags.BoolVar(&plainHTTP, "plain-http", false, "use plain HTTP instead of HTTPS for the target registry. (always used for registries on localhost)")
+	flags.StringVar(&username, "username", "", "username for the target registry.")
+	flags.BoolVar(&passwordStdin, "password-stdin", false, "read the password for the target registry from stdin.")
+
+	_ = mirrorCmd.MarkFlagRequired("to")
+
+	return mirrorCmd
+}
+
+// mirror copies a single image.
+func mirror(ctx context.Context, client *registry.Client, image, mirrored string) error {
+	src, err := registry.ParseReference(image)
+	if err != nil {
+		return err
+	}
+
+	dst, err := registry.ParseReference(mirrored)
+	if err != nil {
+		return err
+	}
+
+	return client.Copy(ctx, src, dst)
+}
+
+// writeRepoList writes the repo list redirecting the test images to the mirror.
+func writeRepoList(filename string, testImages []string, to string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create repo list: %w", err)
+	}
+	defer f.Close()
+
+	if err := images.Print(f, testImages, types.OutputRepoList, to); err != nil {
+		return fmt.Errorf("failed to write repo list: %w", err)
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"sigs.k8s.io/hydrophone/pkg/images"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// imageProblem describes why an image cannot be pulled on all nodes.
+type imageProblem struct {
+	Image   string
+	Problem string
+}
+
+// preflightImages checks that the conformance, busybox and test images exist in
+// their registries for the platforms of all nodes, before any resources for the
+// tests are created. The images that cannot be pulled are printed as a table.
+func preflightImages(ctx context.Context, config *types.Configuration, cluster *cluster) error {
+	platforms, err := nodePlatforms(ctx, cluster.clientset)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for _, p := range platforms {
+		names = append(names, p.String())
+	}
+
+	log.Printf("Checking that all images are available for %s...", strings.Join(names, ", "))
+
+	client := newRegistryClient()
+
+	// the test images are listed by running the conformance image, so it is checked first
+	checked := []string{config.ConformanceImage, config.BusyboxImage}
+	problems := checkImages(ctx, client, checked, platforms)
+
+	if len(problems) == 0 {
+		list, err := listImages(ctx, *config, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to list images: %w", err)
+		}
+
+		// the tests bring their own credentials for these
+		list = slices.DeleteFunc(list, images.RequiresAuth)
+
+		testImages, err := images.ResolveAll(list, config.TestRepo, config.TestRepoList)
+		if err != nil {
+			return err
+		}
+
+		checked = append(checked, testImages...)
+		problems = checkImages(ctx, client, testImages, platforms)
+	}
+
+	if len(problems) == 0 {
+		log.Printf("All %d images are available.", len(checked))
+		return nil
+	}
+
+	if err := printImageProblems(os.Stderr, problems); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%d images cannot be pulled, please check the images and --test-repo or --test-repo-list", len(problems))
+}
+
+// nodePlatforms returns the distinct platforms of all nodes in the cluster.
+func nodePlatforms(ctx context.Context, clientset *kubernetes.Clientset) ([]registry.Platform, error) {
+	nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	platforms := []registry.Platform{}
+	for _, node := range nodes.Items {
+		platform := registry.Platform{
+			OS:           node.Status.NodeInfo.OperatingSystem,
+			Architecture: node.Status.NodeInfo.Architecture,
+		}
+
+		if !slices.Contains(platforms, platform) {
+			platforms = append(platforms, platform)
+		}
+	}
+
+	return platforms, nil
+}
+
+// checkImages returns the problems of the images that do not exist for all platforms.
+func checkImages(ctx context.Context, client *registry.Client, list []string, platforms []registry.Platform) []imageProblem {
+	problems := []imageProblem{}
+
+	for _, image := range list {
+		if problem := checkImage(ctx, client, image, platforms); problem != "" {
+			problems = append(problems, imageProblem{Image: image, Problem: problem})
+		}
+	}
+
+	return problems
+}
+
+// checkImage returns why the image cannot be pulled on all platforms, or an empty string.
+func checkImage(ctx context.Context, client *registry.Client, image string, platforms []registry.Platform) string {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return err.Error()
+	}
+
+	available, err := client.Platforms(ctx, ref)
+	if errors.Is(err, registry.ErrNotFound) {
+		return "manifest not found"
+	}
+	if err != nil {
+		return err.Error()
+	}
+
+	missing := []string{}
+	for _, p := range platforms {
+		if !registry.Supports(available, p.OS, p.Architecture) {
+			missing = append(missing, p.String())
+		}
+	}
+
+	if len(missing) > 0 {
+		return "no manifest for " + strings.Join(missing, ", ")
+	}
+
+	return ""
+}
+
+// printImageProblems writes the images that cannot be pulled as a table.
+func printImageProblems(w io.Writer, problems []imageProblem) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "IMAGE\tPROBLEM")
+	for _, p := range problems {
+		fmt.Fprintf(tw, "%s\t%s\n", p.Image, p.Problem)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintImageProblems(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	require.NoError(t, printImageProblems(buf, []imageProblem{
+		{Image: "registry.k8s.io/pause:3.10", Problem: "no manifest for linux/s390x"},
+		{Image: "mirror.example.com/e2e/agnhost:2.53", Problem: "manifest not found"},
+	}))
+
+	assert.Equal(t, ""+
+		"IMAGE                                PROBLEM\n"+
+		"registry.k8s.io/pause:3.10           no manifest for linux/s390x\n"+
+		"mirror.example.com/e2e/agnhost:2.53  manifest not found\n", buf.String())
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/report"
+
+	"github.com/spf13/cobra"
+)
+
+// newReportCommand creates the command to render a report of previously downloaded test results.
+func newReportCommand() *cobra.Command {
+	var (
+		format     string
+		outputFile string
+	)
+
+	reportCmd := &cobra.Command{
+		Use:   "report <dir>",
+		Short: "Render a report of the results of a previous run.",
+		Long: "Render a self-contained report of the results of a previous run from the junit_01.xml, " +
+			"e2e.log and summary.json files in its output directory.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if format != "html" {
+				return fmt.Errorf("unsupported report format %q, must be: html", format)
+			}
+
+			dir := args[0]
+			if outputFile == "" {
+				outputFile = filepath.Join(dir, "report.html")
+			}
+
+			r, err := report.Load(dir)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Create(outputFile)
+			if err != nil {
+				return fmt.Errorf("failed to create report: %w", err)
+			}
+			defer f.Close()
+
+			if err := r.RenderHTML(f); err != nil {
+				return fmt.Errorf("failed to render report: %w", err)
+			}
+
+			if err := f.Close(); err != nil {
+				return fmt.Errorf("failed to write report: %w", err)
+			}
+
+			log.Printf("Wrote report to %s.", outputFile)
+
+			return nil
+		},
+	}
+
+	reportCmd.Flags().StringVar(&format, "format", "html", "format of the report. Only html is supported.")
+	reportCmd.Flags().StringVar(&outputFile, "output-file", "", "file to write the report to. (default \"<dir>/report.html\")")
+
+	return reportCmd
+}
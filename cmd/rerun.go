@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/conformance/client"
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+// finalReportFile is the name of the JUnit report combining the original run and its rerun.
+const finalReportFile = "junit_final.xml"
+
+// newRerunCommand creates the command to rerun the failed tests of a previous run.
+func newRerunCommand() *cobra.Command {
+	var (
+		rerunCmd *cobra.Command
+		config   types.Configuration
+		from     string
+	)
+
+	rerunCmd = &cobra.Command{
+		Use:   "rerun --from <junit_01.xml>",
+		Short: "Rerun the failed tests of a previous run.",
+		Long: "Rerun the failed tests of a previous run. The results are written into a new output directory " +
+			"(by default next to the given JUnit report), together with a " + finalReportFile + " in which " +
+			"tests that passed when retried replace their original failures and are marked as flaky.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !cmd.Flags().Changed("output-dir") {
+				config.OutputDir = filepath.Join(filepath.Dir(from), "rerun-"+time.Now().UTC().Format("20060102-150405"))
+			}
+
+			effectiveConfig, err := config.Complete(rerunCmd.Flags())
+			if err != nil {
+				_ = rerunCmd.Usage()
+				return err
+			}
+
+			return rerun(cmd.Context(), effectiveConfig, from)
+		},
+	}
+
+	config = types.NewDefaultConfiguration()
+	config.AddFlags(rerunCmd.Flags())
+
+	// like on the root command, these are not part of the configuration file
+	rerunCmd.Flags().StringVar(&skipPreflight, "skip-preflight", "", "skip the namespace and cluster health checks, use the specified namespace.")
+	rerunCmd.Flags().BoolVar(&continueConformance, "continue", false, "connect to an already running conformance test pod.")
+	rerunCmd.Flags().StringVar(&from, "from", "", "JUnit report (junit_01.xml) of the run whose failed tests should be rerun.")
+	_ = rerunCmd.MarkFlagRequired("from")
+
+	return rerunCmd
+}
+
+// rerun runs all specs that failed in the given JUnit report again and merges the
+// new results into a final report.
+func rerun(ctx context.Context, config *types.Configuration, from string) error {
+	if err := validateOutput(config.Output, types.OutputText, types.OutputJSON); err != nil {
+		return err
+	}
+
+	original, err := junit.LoadFile(from)
+	if err != nil {
+		return fmt.Errorf("failed to load previous results: %w", err)
+	}
+
+	failed := original.FailedSpecs()
+	if len(failed) == 0 {
+		log.Printf("No failed tests found in %s, nothing to rerun.", from)
+		return nil
+	}
+
+	names := failedSpecNames(failed)
+
+	log.Printf("Rerunning %d failed test(s) from %s:", len(names), from)
+	for _, name := range names {
+		log.Printf("  %s", name)
+	}
+
+	focus, err := conformance.ExactFocus(names)
+	if err != nil {
+		return fmt.Errorf("failed to select the failed tests: %w", err)
+	}
+
+	cluster, config, err := connect(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	if err := pinImages(ctx, config); err != nil {
+		return err
+	}
+
+	if config.VerifiesSignatures() {
+		if err := verifySignatures(ctx, config); err != nil {
+			return err
+		}
+	}
+
+	testRunner := conformance.NewTestRunner(*config, cluster.restConfig, cluster.clientset)
+	testClient := client.NewClient(cluster.restConfig, cluster.clientset, config.Namespace, config)
+
+	// nothing may be created in the cluster before the permissions are checked
+	if skipPreflight == "" && !continueConformance {
+		if err := testRunner.CheckPermissions(ctx, config.PreflightImages && listsInCluster(ctx, *config, false, true)); err != nil {
+			return err
+		}
+	}
+
+	versions, err := checkVersionSkew(ctx, config, cluster)
+	if err != nil {
+		return fmt.Errorf("version skew check failed: %w", err)
+	}
+
+	if config.PreflightImages && !continueConformance {
+		if err := preflightImages(ctx, config, cluster); err != nil {
+			return fmt.Errorf("image preflight failed: %w", err)
+		}
+	}
+
+	start := time.Now()
+
+	outcome, err := runTests(ctx, config, testRunner, testClient, focus)
+	if err != nil {
+		return err
+	}
+
+	retry, err := junit.LoadFile(filepath.Join(config.OutputDir, junit.ReportFile))
+	if err != nil {
+		return fmt.Errorf("failed to load rerun results: %w", err)
+	}
+
+	final := junit.MergeRetry(original, retry)
+	finalFile := filepath.Join(config.OutputDir, finalReportFile)
+
+	if err := junit.WriteFile(finalFile, final); err != nil {
+		return fmt.Errorf("failed to write final results: %w", err)
+	}
+
+	log.Printf("Wrote final results to %s.", finalFile)
+
+	exitCode, err := finish(config, cluster.serverVersion, versions, focus, finalFile, start, outcome)
+	if err != nil {
+		return err
+	}
+
+	exitWithCode(exitCode)
+
+	return nil
+}
+
+// failedSpecNames returns the names of the failed specs as Ginkgo knows them.
+func failedSpecNames(failed []junit.TestCase) []string {
+	names := make([]string, 0, len(failed))
+	for _, tc := range failed {
+		names = append(names, tc.SpecName())
+	}
+
+	return names
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"regexp"
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/junit"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRerunFocus(t *testing.T) {
+	report := &junit.TestSuites{
+		TestSuites: []junit.TestSuite{{
+			TestCases: []junit.TestCase{
+				{Name: "[SynchronizedBeforeSuite]", Failure: &junit.Failure{}},
+				{Name: "[It] [sig-node] Pods should be submitted and removed [NodeConformance] [Conformance]", Failure: &junit.Failure{}},
+				{Name: "[sig-apps] Deployment should work (v1) [Conformance]", Error: &junit.Failure{}},
+				{Name: "[sig-network] DNS should resolve [Conformance]"},
+			},
+		}},
+	}
+
+	focus, err := conformance.ExactFocus(failedSpecNames(report.FailedSpecs()))
+	require.NoError(t, err)
+
+	// Ginkgo matches the focus against the suite description followed by the spec name
+	matches := func(name string) bool {
+		return regexp.MustCompile(focus).MatchString("Kubernetes e2e suite " + name)
+	}
+
+	assert.True(t, matches("[sig-node] Pods should be submitted and removed [NodeConformance] [Conformance]"))
+	assert.True(t, matches("[sig-apps] Deployment should work (v1) [Conformance]"))
+	assert.False(t, matches("[sig-network] DNS should resolve [Conformance]"))
+}
+
+func TestRerunFlags(t *testing.T) {
+	rerunCmd := newRerunCommand()
+
+	for _, name := range []string{"skip-preflight", "continue", "from"} {
+		assert.NotNil(t, rerunCmd.Flags().Lookup(name), name)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// newResultsCommand creates the command to summarize previously downloaded test results.
+func newResultsCommand() *cobra.Command {
+	var output string
+
+	resultsCmd := &cobra.Command{
+		Use:   "results <dir>",
+		Short: "Summarize the results of a previous run.",
+		Long:  "Summarize the results of a previous run, as downloaded into its output directory.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			if err := validateOutput(output, types.OutputText, types.OutputJSON); err != nil {
+				return err
+			}
+
+			summary, err := results.Load(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to load results: %w", err)
+			}
+
+			if output == types.OutputJSON {
+				return results.PrintJSON(os.Stdout, summary)
+			}
+
+			summary.Print()
+
+			return nil
+		},
+	}
+
+	resultsCmd.Flags().StringVar(&output, "output", types.OutputText, "format of the results summary: text or json (printed to stdout).")
+
+	return resultsCmd
+}
+
+// finish evaluates the results of a test run with the given focus against the known failures, writes the
+// machine-readable summary into the output directory and prints the results in the
+// configured format. It returns the exit code hydrophone should terminate with. An
+// unreadable report is only logged and leaves the exit code of the tests unchanged,
+// containers that did not run the pinned images fail the run.
+func finish(config *types.Configuration, serverVersion *version.Info, versions *results.Versions, focus, reportFile string, start time.Time, outcome testOutcome) (int, error) {
+	run := &results.Run{
+		Command:                commandLine(os.Args),
+		HydrophoneVersion:      hydrophoneVersion(),
+		Focus:                  focus,
+		Configuration:          *config,
+		ServerVersion:          serverVersion,
+		ConformanceImage:       config.ConformanceImage,
+		ConformanceImageDigest: config.ConformanceImageDigest,
+		Versions:               versions,
+		StartTime:              start.UTC(),
+		EndTime:                time.Now().UTC(),
+		ExitCode:               outcome.exitCode,
+		TestExitCode:           outcome.exitCode,
+		ImageMismatches:        outcome.imageMismatches,
+	}
+
+	summary, err := results.LoadFile(reportFile)
+	if err != nil {
+		log.Errorf("Failed to summarize results: %v", err)
+	} else {
+		run.Results = summary
+		run.Verdict = results.Evaluate(summary, config.KnownFailures, run.EndTime)
+		run.ExitCode = run.Verdict.ExitCode(outcome.exitCode)
+	}
+
+	if len(run.ImageMismatches) > 0 && run.ExitCode == 0 {
+		run.ExitCode = 1
+	}
+
+	summaryFile := filepath.Join(config.OutputDir, results.SummaryFile)
+	if err := run.WriteFile(summaryFile); err != nil {
+		return 0, fmt.Errorf("failed to write summary: %w", err)
+	}
+
+	if config.Output == types.OutputJSON {
+		if err := results.PrintJSON(os.Stdout, run); err != nil {
+			return 0, err
+		}
+	} else if summary != nil {
+		summary.Print()
+	}
+
+	if run.Verdict != nil {
+		run.Verdict.Print()
+	}
+
+	for _, mismatch := range run.ImageMismatches {
+		log.Errorf("Image verification failed: %s", mismatch)
+	}
+
+	return run.ExitCode, nil
+}
+
+// commandLine formats the arguments hydrophone was invoked with as a shell command.
+func commandLine(args []string) string {
+	quoted := []string{"hydrophone"}
+
+	for _, arg := range args[1:] {
+		quoted = append(quoted, shellQuote(arg))
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// shellQuote quotes an argument for a POSIX shell, if needed.
+func shellQuote(arg string) string {
+	if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_=.,:/@+") == "" {
+		return arg
+	}
+
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// validateOutput checks that the output format is supported by the current command.
+func validateOutput(output string, formats ...string) error {
+	if output != "" && !slices.Contains(formats, output) {
+		return fmt.Errorf("invalid --output %q, must be one of: %s", output, strings.Join(formats, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandLine(t *testing.T) {
+	args := []string{"/usr/local/bin/hydrophone", "--focus", `\[Conformance\]`, "--output-dir=./results", "--skip", "it's"}
+
+	assert.Equal(t, `hydrophone --focus '\[Conformance\]' --output-dir=./results --skip 'it'\''s'`, commandLine(args))
+}
+
+func TestValidateOutput(t *testing.T) {
+	assert.NoError(t, validateOutput("", "text", "json"))
+	assert.NoError(t, validateOutput("json", "text", "json"))
+	assert.EqualError(t, validateOutput("yaml", "text", "json"), `invalid --output "yaml", must be one of: text, json`)
+}
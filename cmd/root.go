@@ -20,12 +20,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"sigs.k8s.io/hydrophone/pkg/common"
 	"sigs.k8s.io/hydrophone/pkg/conformance"
 	"sigs.k8s.io/hydrophone/pkg/conformance/client"
+	"sigs.k8s.io/hydrophone/pkg/images"
+	"sigs.k8s.io/hydrophone/pkg/junit"
 	"sigs.k8s.io/hydrophone/pkg/log"
 	"sigs.k8s.io/hydrophone/pkg/types"
 
@@ -33,12 +36,14 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
 var (
 	runCleanup          bool
 	runListImages       bool
+	runListTests        bool
 	runConformance      bool
 	continueConformance bool
 	skipPreflight       string
@@ -78,43 +83,186 @@ func New() *cobra.Command {
 	// the different ways to run hydrophone are not part of the configuration file
 	rootCmd.Flags().BoolVar(&runCleanup, "cleanup", false, "cleanup resources (pods, namespaces etc).")
 	rootCmd.Flags().BoolVar(&runListImages, "list-images", false, "list all images that will be used during conformance tests.")
+	rootCmd.Flags().BoolVar(&runListTests, "list-tests", false, "list all tests selected by the focus and skip expressions without running them.")
 	rootCmd.Flags().BoolVar(&runConformance, "conformance", false, "run conformance tests.")
-	rootCmd.Flags().StringVar(&skipPreflight, "skip-preflight", "", "skip namespace check, use the specified namespace.")
+	rootCmd.Flags().StringVar(&skipPreflight, "skip-preflight", "", "skip the namespace and cluster health checks, use the specified namespace.")
 	rootCmd.Flags().BoolVar(&continueConformance, "continue", false, "connect to an already running conformance test pod.")
 	rootCmd.Flags().StringVar(&conformanceFocus, "focus", "", "focus runs a specific e2e test. e.g. - sig-auth. allows regular expressions.")
 
 	rootCmd.MarkFlagsMutuallyExclusive("conformance", "focus", "cleanup", "list-images")
+	rootCmd.MarkFlagsMutuallyExclusive("list-tests", "cleanup", "list-images")
+
+	rootCmd.AddCommand(newRerunCommand())
+	rootCmd.AddCommand(newResultsCommand())
+	rootCmd.AddCommand(newReportCommand())
+	rootCmd.AddCommand(newDiffCommand())
+	rootCmd.AddCommand(newSubmissionCommand())
+	rootCmd.AddCommand(newVerifySubmissionCommand())
+	rootCmd.AddCommand(newCatalogCommand())
+	rootCmd.AddCommand(newMirrorCommand())
 
 	return rootCmd
 }
 
+// cluster is the connection to the cluster under test.
+type cluster struct {
+	restConfig    *rest.Config
+	clientset     *kubernetes.Clientset
+	serverVersion *version.Info
+}
+
 // action implements the main logic flow of the hydrophone command
 func action(ctx context.Context, config *types.Configuration) error {
+	if err := validateModeOutput(config.Output); err != nil {
+		return err
+	}
+
+	// `hydrophone --conformance` is an alias for `hydrophone --focus '\[Conformance\]'`
+	if conformanceFocus == "" {
+		conformanceFocus = `\[Conformance\]`
+	}
+
+	cluster, config, err := connect(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	// only the test pods run the pinned images
+	if !runCleanup && !runListImages && !runListTests {
+		if err := pinImages(ctx, config); err != nil {
+			return err
+		}
+
+		if config.VerifiesSignatures() {
+			if err := verifySignatures(ctx, config); err != nil {
+				return err
+			}
+		}
+	}
+
+	// prepare test runner and the client to monitor it
+	testRunner := conformance.NewTestRunner(*config, cluster.restConfig, cluster.clientset)
+	testClient := client.NewClient(cluster.restConfig, cluster.clientset, config.Namespace, config)
+
+	switch {
+	case runCleanup:
+		if err := testRunner.Cleanup(ctx); err != nil {
+			return fmt.Errorf("failed to cleanup: %w", err)
+		}
+
+	case runListImages:
+		if skipPreflight == "" && listsInCluster(ctx, *config, false, true) {
+			if err := testRunner.CheckListPermissions(ctx); err != nil {
+				return err
+			}
+		}
+
+		list, err := listImages(ctx, *config, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to list images: %w", err)
+		}
+
+		if err := images.Print(os.Stdout, list, config.Output, config.MirrorRegistry); err != nil {
+			return err
+		}
+
+	case runListTests:
+		if skipPreflight == "" && listsInCluster(ctx, *config, true, false) {
+			if err := testRunner.CheckListPermissions(ctx); err != nil {
+				return err
+			}
+		}
+
+		specs, err := listTests(ctx, *config, cluster, conformanceFocus)
+		if err != nil {
+			return fmt.Errorf("failed to list tests: %w", err)
+		}
+
+		log.Printf("%d tests match the focus and skip expressions.", len(specs))
+
+		if err := conformance.PrintSpecs(os.Stdout, specs, config.Output); err != nil {
+			return err
+		}
+
+	default:
+		// nothing may be created in the cluster before the permissions are checked
+		if skipPreflight == "" && !continueConformance {
+			if err := testRunner.CheckPermissions(ctx, config.PreflightImages && listsInCluster(ctx, *config, false, true)); err != nil {
+				return err
+			}
+		}
+
+		versions, err := checkVersionSkew(ctx, config, cluster)
+		if err != nil {
+			return fmt.Errorf("version skew check failed: %w", err)
+		}
+
+		if config.PreflightImages && !continueConformance {
+			if err := preflightImages(ctx, config, cluster); err != nil {
+				return fmt.Errorf("image preflight failed: %w", err)
+			}
+		}
+
+		start := time.Now()
+
+		outcome, err := runTests(ctx, config, testRunner, testClient, conformanceFocus)
+		if err != nil {
+			return err
+		}
+
+		exitCode, err := finish(config, cluster.serverVersion, versions, conformanceFocus, filepath.Join(config.OutputDir, junit.ReportFile), start, outcome)
+		if err != nil {
+			return err
+		}
+
+		exitWithCode(exitCode)
+	}
+
+	return nil
+}
+
+// validateModeOutput checks that the output format is supported by the current run mode.
+func validateModeOutput(output string) error {
+	switch {
+	case runListImages:
+		return validateOutput(output, types.OutputText, types.OutputJSON, types.OutputYAML, types.OutputRepoList)
+	case runListTests:
+		return validateOutput(output, types.OutputText, types.OutputJSON, types.OutputYAML)
+	default:
+		return validateOutput(output, types.OutputText, types.OutputJSON)
+	}
+}
+
+// connect creates the output directory, connects to the cluster and prints the
+// effective configuration, which includes defaults based on the connected cluster.
+func connect(ctx context.Context, config *types.Configuration) (*cluster, *types.Configuration, error) {
 	if err := os.MkdirAll(config.OutputDir, 0o755); err != nil {
-		return fmt.Errorf("error creating output directory: %w", err)
+		return nil, nil, fmt.Errorf("error creating output directory: %w", err)
 	}
 
 	restConfig, err := clientcmd.BuildConfigFromFlags("", config.Kubeconfig)
 	if err != nil {
-		return fmt.Errorf("error loading kubeconfig: %w", err)
+		return nil, nil, fmt.Errorf("error loading kubeconfig: %w", err)
 	}
 
 	clientset, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
-		return fmt.Errorf("error getting config client: %w", err)
+		return nil, nil, fmt.Errorf("error getting config client: %w", err)
 	}
 
 	// some defaults can only be applied after we connected to the cluster
-	config, serverVersion, err := applyClusterDefaults(config, clientset)
+	config, serverVersion, err := applyClusterDefaults(ctx, config, clientset)
 	if err != nil {
-		return fmt.Errorf("error applying cluster configuration: %w", err)
+		return nil, nil, fmt.Errorf("error applying cluster configuration: %w", err)
 	}
 
 	// print effective runtime config before we begin
 	log.Printf("API endpoint: %s", restConfig.Host)
 	log.Printf("Server version: %#v", *serverVersion)
 	log.Printf("Using namespace: %s", config.Namespace)
-	log.Printf("Using conformance image: %s", config.ConformanceImage)
+	if config.ConformanceImage != "" {
+		log.Printf("Using conformance image: %s", config.ConformanceImage)
+	}
 	log.Printf("Using busybox image: %s", config.BusyboxImage)
 
 	if config.Skip != "" {
@@ -127,95 +275,164 @@ func action(ctx context.Context, config *types.Configuration) error {
 
 	log.Printf("Test framework will start %d thread(s) and use verbosity level %d.", config.Parallel, config.Verbosity)
 
-	// prepare test runner and the client to monitor it
-	testRunner := conformance.NewTestRunner(*config, clientset)
-	testClient := client.NewClient(restConfig, clientset, config.Namespace, config)
+	return &cluster{
+		restConfig:    restConfig,
+		clientset:     clientset,
+		serverVersion: serverVersion,
+	}, config, nil
+}
 
-	switch {
-	case runCleanup:
-		if err := testRunner.Cleanup(ctx); err != nil {
-			return fmt.Errorf("failed to cleanup: %w", err)
-		}
+// testOutcome is the outcome of running all test phases.
+type testOutcome struct {
+	// exitCode is the exit code of the test suite.
+	exitCode int
+	// imageMismatches describe the containers that did not run the pinned images.
+	imageMismatches []string
+}
 
-	case runListImages:
-		if err := testRunner.PrintListImages(ctx, config.StartupTimeout); err != nil {
-			return fmt.Errorf("failed to list images: %w", err)
-		}
+// runTests runs all test phases for the given focus, merges their results into the
+// output directory and cleans up afterwards. It returns the exit code of the tests
+// and the containers that did not run the pinned images.
+func runTests(ctx context.Context, config *types.Configuration, testRunner *conformance.TestRunner, testClient *client.Client, focus string) (testOutcome, error) {
+	verboseGinkgo := config.Verbosity >= 6
+	// the spinner writes to stdout, which is reserved for the summary in JSON mode
+	showSpinner := !verboseGinkgo && config.Verbosity > 2 && config.Output != types.OutputJSON
 
-	default:
-		// `hydrophone --conformance` is an alias for `hydrophone --focus '\[Conformance\]'`
-		if conformanceFocus == "" {
-			conformanceFocus = `\[Conformance\]`
-		}
+	if continueConformance {
+		log.Println("Attempting to continue with already running tests...")
+	}
 
-		verboseGinkgo := config.Verbosity >= 6
-		showSpinner := !verboseGinkgo && config.Verbosity > 2
+	phases, err := testRunner.Phases(ctx, focus, continueConformance, config.StartupTimeout)
+	if err != nil {
+		return testOutcome{}, fmt.Errorf("failed to plan test phases: %w", err)
+	}
+	if len(phases) > 1 {
+		log.Printf("Tests will be run in %d phases.", len(phases))
+	}
+
+	outcome := testOutcome{}
+	phaseDirs := []string{}
 
-		if continueConformance {
-			log.Println("Attempting to continue with already running tests...")
-		} else {
-			if err := testRunner.Deploy(ctx, conformanceFocus, skipPreflight, verboseGinkgo, config.StartupTimeout); err != nil {
-				return fmt.Errorf("failed to deploy tests: %w", err)
+	for i, phase := range phases {
+		phaseDir := config.OutputDir
+		if len(phases) > 1 {
+			phaseDir = filepath.Join(config.OutputDir, phase.Name)
+			if err := os.MkdirAll(phaseDir, 0o755); err != nil {
+				return testOutcome{}, fmt.Errorf("error creating output directory: %w", err)
 			}
 		}
 
-		before := time.Now()
+		phaseClient := testClient.ForPods(phase.PodNames()...)
 
-		var spinner *common.Spinner
-		if showSpinner {
-			spinner = common.NewSpinner(os.Stdout)
-			spinner.Start()
+		code, mismatches, err := runPhase(ctx, testRunner, phaseClient, phase, i == 0, phaseDir, config, verboseGinkgo, showSpinner)
+		if err != nil {
+			return testOutcome{}, err
 		}
 
-		// PrintE2ELogs is a long-running method
-		if err := testClient.PrintE2ELogs(ctx); err != nil {
-			return fmt.Errorf("failed to get test logs: %w", err)
+		if outcome.exitCode == 0 {
+			outcome.exitCode = code
 		}
 
-		if showSpinner {
-			spinner.Stop()
-		}
+		outcome.imageMismatches = append(outcome.imageMismatches, mismatches...)
 
-		log.Printf("Tests finished after %v.", time.Since(before).Round(time.Second))
+		phaseDirs = append(phaseDirs, phaseDir)
+	}
 
-		if err := testClient.FetchFiles(ctx, config.OutputDir); err != nil {
-			return fmt.Errorf("failed to download results: %w", err)
+	if len(phases) > 1 {
+		if err := client.MergeFiles(config.OutputDir, phaseDirs); err != nil {
+			return testOutcome{}, fmt.Errorf("failed to merge results: %w", err)
 		}
+	}
 
-		exitCode, err := testClient.FetchExitCode(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to determine exit code: %w", err)
+	if err := testRunner.Cleanup(ctx); err != nil {
+		return testOutcome{}, fmt.Errorf("failed to cleanup: %w", err)
+	}
+
+	return outcome, nil
+}
+
+// exitWithCode reports the outcome of a test run and terminates hydrophone with
+// the exit code of the tests if they failed.
+func exitWithCode(exitCode int) {
+	if exitCode == 0 {
+		log.Println("Tests completed successfully.")
+	} else {
+		log.Errorf("Tests failed (code %d).", exitCode)
+		os.Exit(exitCode)
+	}
+}
+
+// runPhase deploys a single test phase (unless it is already running), waits for it
+// to finish and downloads its results. It returns the exit code of the test suite and
+// the containers that did not run the pinned images.
+func runPhase(ctx context.Context, testRunner *conformance.TestRunner, testClient *client.Client, phase conformance.Phase, first bool, outputDir string, config *types.Configuration, verboseGinkgo, showSpinner bool) (int, []string, error) {
+	deployed := false
+	if continueConformance {
+		var err error
+		if deployed, err = testRunner.IsDeployed(ctx, phase); err != nil {
+			return 0, nil, fmt.Errorf("failed to check for %s test phase: %w", phase.Name, err)
 		}
+	}
 
-		if err := testRunner.Cleanup(ctx); err != nil {
-			return fmt.Errorf("failed to cleanup: %w", err)
+	switch {
+	case deployed:
+		log.Printf("Continuing %s test phase.", phase.Name)
+
+	case first && !continueConformance:
+		if err := testRunner.Deploy(ctx, phase, skipPreflight, verboseGinkgo, config.StartupTimeout); err != nil {
+			return 0, nil, fmt.Errorf("failed to deploy tests: %w", err)
 		}
 
-		if exitCode == 0 {
-			log.Println("Tests completed successfully.")
-		} else {
-			log.Errorf("Tests failed (code %d).", exitCode)
-			os.Exit(exitCode)
+	default:
+		if err := testRunner.DeployPhase(ctx, phase, skipPreflight, verboseGinkgo, config.StartupTimeout); err != nil {
+			return 0, nil, fmt.Errorf("failed to deploy tests: %w", err)
 		}
 	}
 
-	return nil
+	before := time.Now()
+
+	var spinner *common.Spinner
+	if showSpinner {
+		spinner = common.NewSpinner(os.Stdout)
+		spinner.Start()
+	}
+
+	// PrintE2ELogs is a long-running method
+	if err := testClient.PrintE2ELogs(ctx); err != nil {
+		return 0, nil, fmt.Errorf("failed to get test logs: %w", err)
+	}
+
+	if showSpinner {
+		spinner.Stop()
+	}
+
+	log.Printf("Tests finished after %v.", time.Since(before).Round(time.Second))
+
+	if err := testClient.FetchFiles(ctx, outputDir); err != nil {
+		return 0, nil, fmt.Errorf("failed to download results: %w", err)
+	}
+
+	exitCode, err := testClient.FetchExitCode(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to determine exit code: %w", err)
+	}
+
+	// mismatches fail the run only after the results were summarized and cleaned up
+	return exitCode, testClient.VerifyImages(ctx), nil
 }
 
 // applyClusterDefaults sets configuration defaults based on the connected cluster
-func applyClusterDefaults(config *types.Configuration, clientset *kubernetes.Clientset) (*types.Configuration, *version.Info, error) {
+func applyClusterDefaults(ctx context.Context, config *types.Configuration, clientset *kubernetes.Clientset) (*types.Configuration, *version.Info, error) {
 	serverVersion, err := clientset.ServerVersion()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed fetching server version: %w", err)
 	}
 
-	normalized, err := normalizeVersion(serverVersion.String())
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed parsing server version: %w", err)
-	}
-
-	if config.ConformanceImage == "" {
-		config.ConformanceImage = fmt.Sprintf("registry.k8s.io/conformance:%s", normalized)
+	// cleaning up does not need the conformance image, listing prefers cataloged ones
+	if config.ConformanceImage == "" && !runCleanup {
+		if config.ConformanceImage, err = defaultConformanceImage(ctx, serverVersion.String(), config.MirrorRegistry, runListTests || runListImages); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	return config, serverVersion, nil
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/signature"
+	"sigs.k8s.io/hydrophone/pkg/types"
+)
+
+// verifySignatures verifies the signatures of the pinned conformance and busybox
+// images, before any resources for the tests are created.
+func verifySignatures(ctx context.Context, config *types.Configuration) error {
+	verifier, err := signature.NewVerifier(newRegistryClient(), signature.Options{
+		KeyFile:         config.SignatureKey,
+		TrustBundleFile: config.SignatureTrustBundle,
+		Identity:        config.SignatureIdentity,
+		Issuer:          config.SignatureIssuer,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load signature verification keys: %w", err)
+	}
+
+	for _, image := range []string{config.PinnedConformanceImage(), config.PinnedBusyboxImage()} {
+		if err := verifier.Verify(ctx, image); err != nil {
+			return fmt.Errorf("signature verification failed: %w", err)
+		}
+
+		log.Printf("Verified signature of %s.", image)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/submission"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// defaultCommand is assumed to have produced results that carry no record of their invocation.
+const defaultCommand = "hydrophone --conformance"
+
+// newSubmissionCommand creates the command to assemble a CNCF conformance submission.
+func newSubmissionCommand() *cobra.Command {
+	var (
+		product           submission.Product
+		productFile       string
+		kubernetesVersion string
+		submissionDir     string
+	)
+
+	submissionCmd := &cobra.Command{
+		Use:   "submission <dir>",
+		Short: "Assemble a CNCF conformance submission from the results of a previous run.",
+		Long: "Assemble a submission for the CNCF Kubernetes conformance program from the results of a previous run. " +
+			"The product metadata can be given as flags or as a PRODUCT.yaml-style file; flags take precedence. " +
+			"The submission is written into <submission-dir>/vX.Y/<product>/.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			resultsDir := args[0]
+
+			merged := &submission.Product{}
+			if productFile != "" {
+				loaded, err := submission.LoadProduct(productFile)
+				if err != nil {
+					return err
+				}
+				merged = loaded
+			}
+
+			overwriteProduct(cmd.Flags(), merged, &product)
+
+			if err := merged.Validate(); err != nil {
+				return err
+			}
+
+			sub := &submission.Submission{
+				Product:           merged,
+				Command:           defaultCommand,
+				HydrophoneVersion: hydrophoneVersion(),
+			}
+
+			run, err := results.LoadRunFile(filepath.Join(resultsDir, results.SummaryFile))
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				log.Warnf("No %s found in %s, assuming the results were created by %q.", results.SummaryFile, resultsDir, defaultCommand)
+			case err != nil:
+				return fmt.Errorf("failed to load run summary: %w", err)
+			default:
+				if run.Command != "" {
+					sub.Command = run.Command
+				}
+
+				// summaries of older versions do not record the version
+				if run.HydrophoneVersion != "" {
+					sub.HydrophoneVersion = run.HydrophoneVersion
+				}
+
+				if kubernetesVersion == "" && run.ServerVersion != nil {
+					kubernetesVersion = run.ServerVersion.GitVersion
+				}
+
+				if run.Results != nil && run.Results.Failed > 0 {
+					log.Warnf("The results contain %d failed test(s), only passing runs are accepted for certification.", run.Results.Failed)
+				}
+			}
+
+			if sub.HydrophoneVersion == "" {
+				log.Warnf("Unknown hydrophone version, the README refers to the latest version.")
+			}
+
+			if kubernetesVersion == "" {
+				return errors.New("unknown Kubernetes version, please set --kubernetes-version")
+			}
+
+			if sub.KubernetesVersion, err = submission.MinorVersion(kubernetesVersion); err != nil {
+				return err
+			}
+
+			dir, err := sub.Create(resultsDir, submissionDir)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("Created submission in %s.", dir)
+
+			return nil
+		},
+	}
+
+	flags := submissionCmd.Flags()
+	flags.StringVar(&productFile, "product-file", "", "YAML file with the product metadata, in the format of PRODUCT.yaml.")
+	flags.StringVar(&kubernetesVersion, "kubernetes-version", "", "Kubernetes version the product is certified for. (default: the server version recorded in summary.json)")
+	flags.StringVar(&submissionDir, "submission-dir", ".", "directory to create the submission in.")
+	flags.StringVar(&product.Vendor, "vendor", "", "name of the legal entity that is certifying the product.")
+	flags.StringVar(&product.Name, "product-name", "", "name of the product being certified.")
+	flags.StringVar(&product.Version, "product-version", "", "version of the product being certified.")
+	flags.StringVar(&product.WebsiteURL, "website-url", "", "URL of the product website.")
+	flags.StringVar(&product.RepoURL, "repo-url", "", "URL of the product's source code repository, if open source.")
+	flags.StringVar(&product.DocumentationURL, "documentation-url", "", "URL of the product documentation.")
+	flags.StringVar(&product.ProductLogoURL, "product-logo-url", "", "URL of the product logo.")
+	flags.StringVar(&product.Type, "product-type", "", "type of the product: distribution, hosted platform or installer.")
+	flags.StringVar(&product.Description, "description", "", "one-sentence description of the product.")
+	flags.StringVar(&product.ContactEmail, "contact-email", "", "email address for questions about the submission.")
+
+	return submissionCmd
+}
+
+// overwriteProduct applies the product metadata given as flags to the loaded metadata.
+func overwriteProduct(fs *pflag.FlagSet, dst, src *submission.Product) {
+	fields := map[string][2]*string{
+		"vendor":            {&dst.Vendor, &src.Vendor},
+		"product-name":      {&dst.Name, &src.Name},
+		"product-version":   {&dst.Version, &src.Version},
+		"website-url":       {&dst.WebsiteURL, &src.WebsiteURL},
+		"repo-url":          {&dst.RepoURL, &src.RepoURL},
+		"documentation-url": {&dst.DocumentationURL, &src.DocumentationURL},
+		"product-logo-url":  {&dst.ProductLogoURL, &src.ProductLogoURL},
+		"product-type":      {&dst.Type, &src.Type},
+		"description":       {&dst.Description, &src.Description},
+		"contact-email":     {&dst.ContactEmail, &src.ContactEmail},
+	}
+
+	for flag, field := range fields {
+		if fs.Changed(flag) {
+			*field[0] = *field[1]
+		}
+	}
+}
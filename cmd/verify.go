@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/submission"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+// newVerifySubmissionCommand creates the command to check results against the certification requirements.
+func newVerifySubmissionCommand() *cobra.Command {
+	var (
+		expectedSpecs int
+		kubeconfig    string
+	)
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify-submission <dir>",
+		Short: "Check the results of a previous run against the certification requirements.",
+		Long: "Check the results of a previous run against the requirements of the CNCF conformance program and report " +
+			"every violated rule. Unless --expected-specs is given, the number of conformance tests is determined by " +
+			"listing the tests of the conformance image used for the run, which requires access to a cluster. " +
+			"Exits with code 1 if any rule is violated.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := args[0]
+
+			summary, err := results.Load(dir)
+			if err != nil {
+				return fmt.Errorf("failed to load results: %w", err)
+			}
+
+			e2eLog, err := os.ReadFile(filepath.Join(dir, submission.LogFile))
+			if err != nil {
+				return fmt.Errorf("failed to load e2e log: %w", err)
+			}
+
+			run, err := results.LoadRunFile(filepath.Join(dir, results.SummaryFile))
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("failed to load run summary: %w", err)
+			}
+
+			if expectedSpecs < 0 && run != nil {
+				config := run.Configuration
+				if kubeconfig != "" {
+					config.Kubeconfig = kubeconfig
+				}
+
+				if expectedSpecs, err = countConformanceSpecs(cmd.Context(), config); err != nil {
+					log.Warnf("Failed to determine the number of conformance tests: %v", err)
+					expectedSpecs = -1
+				}
+			}
+
+			violations := submission.Verify(&submission.Evidence{
+				Run:           run,
+				Summary:       summary,
+				Log:           string(e2eLog),
+				ExpectedSpecs: expectedSpecs,
+			})
+
+			if len(violations) == 0 {
+				log.Println("The results meet all certification requirements.")
+				return nil
+			}
+
+			for _, violation := range violations {
+				log.Errorf("[%s] %s", violation.Rule, violation.Message)
+			}
+
+			log.Errorf("The results violate %d certification requirement(s).", len(violations))
+			os.Exit(1)
+
+			return nil
+		},
+	}
+
+	verifyCmd.Flags().IntVar(&expectedSpecs, "expected-specs", -1, "number of conformance tests the run must have executed. (default: determined from the conformance image)")
+	verifyCmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to the kubeconfig file used to list the tests of the conformance image. (default: the kubeconfig of the run)")
+
+	return verifyCmd
+}
+
+// countConformanceSpecs returns the number of conformance specs of the configured conformance image.
+func countConformanceSpecs(ctx context.Context, config types.Configuration) (int, error) {
+	// user-supplied filters must not reduce the expected number of specs
+	config.Skip = ""
+	config.ExtraGinkgoArgs = nil
+
+	specs, err := listTests(ctx, config, nil, submission.ConformanceFocus)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(specs), nil
+}
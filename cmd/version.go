@@ -79,6 +79,17 @@ func buildVersionString() string {
 	return b.String()
 }
 
+// hydrophoneVersion returns the module version of the running binary, e.g. v0.7.0,
+// or an empty string if it was not built from a module version.
+func hydrophoneVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok || info.Main.Version == "(devel)" {
+		return ""
+	}
+
+	return info.Main.Version
+}
+
 func emptyDash(s string) string {
 	if s == "" {
 		return "-"
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/blang/semver/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxNodeSkew is the number of minor versions kubelets and kube-proxies may be
+// older than the API server.
+const maxNodeSkew = 3
+
+// checkVersionSkew reads the versions of the kubelets and kube-proxies of all
+// nodes, prints them with the versions of the API server and conformance image
+// and checks the skew between them. Unsupported skews are only logged as warnings,
+// unless --strict-version is set.
+func checkVersionSkew(ctx context.Context, config *types.Configuration, cluster *cluster) (*results.Versions, error) {
+	nodes, err := cluster.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	versions := &results.Versions{
+		Server:           cluster.serverVersion.GitVersion,
+		ConformanceImage: imageVersion(config.ConformanceImage),
+	}
+
+	// images referenced by digest only are assumed to match the server, like the default image
+	if versions.ConformanceImage == "" {
+		if tag, err := normalizeVersion(versions.Server); err == nil {
+			log.Warnf("Conformance image %s has no tag, assuming version %s of the API server.", config.ConformanceImage, tag)
+			versions.ConformanceImage = tag
+		}
+	}
+
+	if _, err := semver.ParseTolerant(versions.ConformanceImage); err != nil {
+		log.Warnf("Cannot determine the version of conformance image %s, its skew to the API server is not checked.", config.ConformanceImage)
+	}
+
+	for _, node := range nodes.Items {
+		versions.Nodes = append(versions.Nodes, results.NodeVersions{
+			Name:    node.Name,
+			Kubelet: node.Status.NodeInfo.KubeletVersion,
+			//nolint:staticcheck // the field is deprecated, but still reported by many kubelets
+			KubeProxy: node.Status.NodeInfo.KubeProxyVersion,
+		})
+	}
+
+	if err := versions.Print(os.Stderr); err != nil {
+		return nil, err
+	}
+
+	skews := versionSkews(versions)
+	if len(skews) == 0 {
+		return versions, nil
+	}
+
+	if config.StrictVersion {
+		return nil, fmt.Errorf("unsupported version skew:\n  %s", strings.Join(skews, "\n  "))
+	}
+
+	for _, skew := range skews {
+		log.Warnf("Unsupported version skew: %s", skew)
+	}
+
+	return versions, nil
+}
+
+// imageVersion returns the tag of an image, or an empty string if it has none.
+func imageVersion(image string) string {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return ""
+	}
+
+	return ref.Tag
+}
+
+// versionSkews returns the unsupported skews between the API server and the
+// conformance image, kubelets and kube-proxies, following the Kubernetes version
+// skew policy. Node versions that cannot be parsed are reported as well, while
+// an unknown version of the conformance image is not.
+func versionSkews(versions *results.Versions) []string {
+	server, err := semver.ParseTolerant(versions.Server)
+	if err != nil {
+		return []string{fmt.Sprintf("cannot parse API server version %q", versions.Server)}
+	}
+
+	skews := []string{}
+
+	if image, err := semver.ParseTolerant(versions.ConformanceImage); err == nil && (image.Major != server.Major || image.Minor != server.Minor) {
+		skews = append(skews, fmt.Sprintf("conformance image %s is for a different minor version than the API server %s", versions.ConformanceImage, versions.Server))
+	}
+
+	for _, node := range versions.Nodes {
+		if err := nodeSkew(server, node.Kubelet); err != nil {
+			skews = append(skews, fmt.Sprintf("kubelet %s on node %s %v", node.Kubelet, node.Name, err))
+		}
+
+		// not every node reports its kube-proxy version
+		if node.KubeProxy == "" {
+			continue
+		}
+
+		if err := nodeSkew(server, node.KubeProxy); err != nil {
+			skews = append(skews, fmt.Sprintf("kube-proxy %s on node %s %v", node.KubeProxy, node.Name, err))
+		}
+	}
+
+	return skews
+}
+
+// nodeSkew checks that a node component is not newer than the API server and at
+// most maxNodeSkew minor versions older.
+func nodeSkew(server semver.Version, componentVersion string) error {
+	component, err := semver.ParseTolerant(componentVersion)
+	if err != nil {
+		return errors.New("has an invalid version")
+	}
+
+	switch {
+	case component.Major != server.Major:
+		return fmt.Errorf("has a different major version than the API server v%d.%d", server.Major, server.Minor)
+	case component.Minor > server.Minor:
+		return fmt.Errorf("is newer than the API server v%d.%d", server.Major, server.Minor)
+	case server.Minor-component.Minor > maxNodeSkew:
+		return fmt.Errorf("is more than %d minor versions older than the API server v%d.%d", maxNodeSkew, server.Major, server.Minor)
+	}
+
+	return nil
+}
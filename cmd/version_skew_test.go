@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/results"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionSkews(t *testing.T) {
+	testCases := []struct {
+		name     string
+		versions results.Versions
+		expected []string
+	}{
+		{
+			name: "supported skew",
+			versions: results.Versions{
+				Server:           "v1.35.1-gke.1200",
+				ConformanceImage: "v1.35.0",
+				Nodes: []results.NodeVersions{
+					{Name: "node-1", Kubelet: "v1.35.1", KubeProxy: "v1.35.1"},
+					{Name: "node-2", Kubelet: "v1.32.4"},
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name: "unsupported skew",
+			versions: results.Versions{
+				Server:           "v1.35.1",
+				ConformanceImage: "v1.34.3",
+				Nodes: []results.NodeVersions{
+					{Name: "node-1", Kubelet: "v1.36.0", KubeProxy: "v1.31.0"},
+					{Name: "node-2", Kubelet: "unknown"},
+				},
+			},
+			expected: []string{
+				"conformance image v1.34.3 is for a different minor version than the API server v1.35.1",
+				"kubelet v1.36.0 on node node-1 is newer than the API server v1.35",
+				"kube-proxy v1.31.0 on node node-1 is more than 3 minor versions older than the API server v1.35",
+				"kubelet unknown on node node-2 has an invalid version",
+			},
+		},
+		{
+			name: "custom conformance image",
+			versions: results.Versions{
+				Server:           "v1.35.1",
+				ConformanceImage: "latest",
+			},
+			expected: []string{},
+		},
+		{
+			name: "conformance image without tag",
+			versions: results.Versions{
+				Server: "v1.35.1",
+				Nodes:  []results.NodeVersions{{Name: "node-1", Kubelet: "v1.35.1"}},
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, versionSkews(&tc.versions))
+		})
+	}
+}
+
+func TestImageVersion(t *testing.T) {
+	assert.Equal(t, "v1.35.1", imageVersion("registry.k8s.io/conformance:v1.35.1"))
+	assert.Equal(t, "v1.35.1", imageVersion("localhost:5000/conformance:v1.35.1@sha256:ee6521f290b2168b6e0935a181d4cff9be1ac3f505666ef0e3c98fae8199917a"))
+	assert.Empty(t, imageVersion("registry.k8s.io/conformance@sha256:ee6521f290b2168b6e0935a181d4cff9be1ac3f505666ef0e3c98fae8199917a"))
+}
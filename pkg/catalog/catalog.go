@@ -0,0 +1,356 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/log"
+)
+
+// unsafeChars matches everything in an image reference that cannot be part of a file name.
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// Catalog is the list of tests and test images contained in a conformance image.
+type Catalog struct {
+	// Image is the reference of the conformance image, as given to hydrophone.
+	Image string `json:"image" yaml:"image"`
+	// Digest is the digest of the manifest the image was resolved to, or empty if
+	// it could not be resolved when the catalog was created.
+	Digest string `json:"digest,omitempty" yaml:"digest,omitempty"`
+	// Updated is the time the catalog was last changed.
+	Updated time.Time `json:"updated" yaml:"updated"`
+	// Specs are all specs of the e2e suite, or nil if they were not listed yet.
+	Specs []conformance.Spec `json:"specs,omitempty" yaml:"specs,omitempty"`
+	// Images are the images used by the tests, or nil if they were not listed yet.
+	Images []string `json:"images,omitempty" yaml:"images,omitempty"`
+}
+
+// Lister enumerates the contents of a conformance image, usually by running it in
+// the cluster. The tests must be listed without any focus, skip or label filter.
+type Lister interface {
+	ListTests(ctx context.Context, phase conformance.Phase, timeout time.Duration) ([]conformance.Spec, error)
+	ListImages(ctx context.Context, timeout time.Duration) ([]string, error)
+}
+
+// Cache stores one catalog per conformance image on disk. Catalogs are keyed by
+// the digest of the image, so that a tag pushed again is cataloged anew. Images
+// whose digest cannot be resolved are keyed by their reference.
+type Cache struct {
+	Dir string
+	// Resolve returns the digest of the manifest of an image. If it is nil, only
+	// references containing a digest are keyed by it.
+	Resolve func(ctx context.Context, image string) (string, error)
+}
+
+// DefaultCache returns the cache in the cache directory of the current user.
+func DefaultCache() (*Cache, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine cache directory: %w", err)
+	}
+
+	return &Cache{Dir: filepath.Join(dir, "hydrophone", "catalog")}, nil
+}
+
+// path returns the file storing the catalog with the given key.
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.Dir, unsafeChars.ReplaceAllString(key, "_")+".json")
+}
+
+// digest returns the digest of the image, or an empty string if it cannot be resolved.
+func (c *Cache) digest(ctx context.Context, image string) string {
+	if _, digest, found := strings.Cut(image, "@"); found {
+		return digest
+	}
+
+	if c.Resolve == nil {
+		return ""
+	}
+
+	digest, err := c.Resolve(ctx, image)
+	if err != nil {
+		log.Warnf("Failed to resolve %s to a digest, using its catalog by reference: %v", image, err)
+		return ""
+	}
+
+	return digest
+}
+
+// Load returns the cached catalog of the current digest of the given image. If the
+// image cannot be resolved, e.g. offline, the most recently updated catalog created
+// for its reference is returned instead. The error wraps fs.ErrNotExist if the
+// image has not been cataloged yet.
+func (c *Cache) Load(ctx context.Context, image string) (*Catalog, error) {
+	if digest := c.digest(ctx, image); digest != "" {
+		return c.read(image, digest)
+	}
+
+	entries, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *Catalog
+	for _, entry := range entries {
+		if entry.catalog.Image == image && (latest == nil || entry.catalog.Updated.After(latest.Updated)) {
+			latest = entry.catalog
+		}
+	}
+
+	if latest == nil {
+		return nil, fmt.Errorf("failed to read catalog of %s: %w", image, fs.ErrNotExist)
+	}
+
+	return latest, nil
+}
+
+// Lookup returns the cached catalog of the current digest of the given image, or
+// of its reference if it cannot be resolved. The error wraps fs.ErrNotExist if
+// the image has not been cataloged yet.
+func (c *Cache) Lookup(ctx context.Context, image string) (*Catalog, error) {
+	return c.read(image, cmp.Or(c.digest(ctx, image), image))
+}
+
+// read returns the catalog with the given key.
+func (c *Cache) read(image, key string) (*Catalog, error) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog of %s: %w", image, err)
+	}
+
+	catalog := &Catalog{}
+	if err := json.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("invalid catalog of %s: %w", image, err)
+	}
+
+	return catalog, nil
+}
+
+// Save stores the catalog, replacing any previous catalog of the same image.
+func (c *Cache) Save(catalog *Catalog) error {
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	catalog.Updated = time.Now().UTC()
+
+	data, err := json.Marshal(catalog)
+	if err != nil {
+		return fmt.Errorf("failed to encode catalog: %w", err)
+	}
+
+	// write to a temporary file first, so that a concurrent run never reads a partial catalog
+	path := c.path(cmp.Or(catalog.Digest, catalog.Image))
+	if err := os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("failed to write catalog: %w", err)
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// List returns all cached catalogs, sorted by image.
+func (c *Cache) List() ([]*Catalog, error) {
+	entries, err := c.entries()
+	if err != nil {
+		return nil, err
+	}
+
+	catalogs := []*Catalog{}
+	for _, entry := range entries {
+		catalogs = append(catalogs, entry.catalog)
+	}
+
+	slices.SortFunc(catalogs, func(a, b *Catalog) int {
+		return strings.Compare(a.Image, b.Image)
+	})
+
+	return catalogs, nil
+}
+
+// entry is a cached catalog and the file storing it.
+type entry struct {
+	file    string
+	catalog *Catalog
+}
+
+// entries reads all cached catalogs.
+func (c *Cache) entries() ([]entry, error) {
+	files, err := filepath.Glob(filepath.Join(c.Dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []entry{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read catalog: %w", err)
+		}
+
+		catalog := &Catalog{}
+		if err := json.Unmarshal(data, catalog); err != nil {
+			return nil, fmt.Errorf("invalid catalog %s: %w", file, err)
+		}
+
+		entries = append(entries, entry{file: file, catalog: catalog})
+	}
+
+	return entries, nil
+}
+
+// Remove deletes all cached catalogs of the given image: the catalog of its
+// current digest, whatever reference it was created for, and all catalogs created
+// for its reference, whatever digest they were created for.
+func (c *Cache) Remove(ctx context.Context, image string) error {
+	digest := c.digest(ctx, image)
+
+	entries, err := c.entries()
+	if err != nil {
+		return err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.catalog.Image != image && (digest == "" || entry.catalog.Digest != digest) {
+			continue
+		}
+
+		if err := os.Remove(entry.file); err != nil {
+			return fmt.Errorf("failed to remove catalog of %s: %w", image, err)
+		}
+
+		removed++
+	}
+
+	if removed == 0 {
+		return fmt.Errorf("failed to remove catalog of %s: %w", image, fs.ErrNotExist)
+	}
+
+	return nil
+}
+
+// Clear deletes all cached catalogs.
+func (c *Cache) Clear() error {
+	if err := os.RemoveAll(c.Dir); err != nil {
+		return fmt.Errorf("failed to clear catalog cache: %w", err)
+	}
+
+	return nil
+}
+
+// Specs returns all specs of the given image, listing and caching them first if
+// they are not cached yet.
+func (c *Cache) Specs(ctx context.Context, image string, lister Lister, timeout time.Duration) ([]conformance.Spec, error) {
+	catalog, err := c.lookupOrCreate(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	if catalog.Specs != nil {
+		log.Printf("Using cached tests of %s.", image)
+		return catalog.Specs, nil
+	}
+
+	log.Printf("Listing tests of %s...", image)
+
+	if catalog.Specs, err = lister.ListTests(ctx, conformance.Phase{}, timeout); err != nil {
+		return nil, err
+	}
+
+	if err := c.Save(catalog); err != nil {
+		return nil, err
+	}
+
+	return catalog.Specs, nil
+}
+
+// Images returns all test images used by the given image, listing and caching
+// them first if they are not cached yet.
+func (c *Cache) Images(ctx context.Context, image string, lister Lister, timeout time.Duration) ([]string, error) {
+	catalog, err := c.lookupOrCreate(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	if catalog.Images != nil {
+		log.Printf("Using cached images of %s.", image)
+		return catalog.Images, nil
+	}
+
+	log.Printf("Listing images of %s...", image)
+
+	if catalog.Images, err = lister.ListImages(ctx, timeout); err != nil {
+		return nil, err
+	}
+
+	if err := c.Save(catalog); err != nil {
+		return nil, err
+	}
+
+	return catalog.Images, nil
+}
+
+// lookupOrCreate returns the cached catalog of the current digest of the given
+// image or a new, empty one.
+func (c *Cache) lookupOrCreate(ctx context.Context, image string) (*Catalog, error) {
+	digest := c.digest(ctx, image)
+
+	catalog, err := c.read(image, cmp.Or(digest, image))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Catalog{Image: image, Digest: digest}, nil
+	}
+
+	return catalog, err
+}
+
+// Filter returns the specs whose name matches the focus and does not match the
+// skip expression, like Ginkgo selects the specs to run. Empty expressions are ignored.
+func Filter(specs []conformance.Spec, focus, skip string) ([]conformance.Spec, error) {
+	focusRegex, err := regexp.Compile(focus)
+	if err != nil {
+		return nil, fmt.Errorf("invalid focus: %w", err)
+	}
+
+	var skipRegex *regexp.Regexp
+	if skip != "" {
+		if skipRegex, err = regexp.Compile(skip); err != nil {
+			return nil, fmt.Errorf("invalid skip: %w", err)
+		}
+	}
+
+	matched := []conformance.Spec{}
+	for _, spec := range specs {
+		if focusRegex.MatchString(spec.Name) && (skipRegex == nil || !skipRegex.MatchString(spec.Name)) {
+			matched = append(matched, spec)
+		}
+	}
+
+	return matched, nil
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSpecs = []conformance.Spec{
+	{Name: "[sig-apps] Deployment should work [Conformance]"},
+	{Name: "[sig-node] Pods should be evicted [Serial] [Conformance]"},
+	{Name: "[sig-storage] Volumes should mount"},
+}
+
+// fakeLister counts how often the conformance image was run.
+type fakeLister struct {
+	calls int
+}
+
+func (l *fakeLister) ListTests(context.Context, conformance.Phase, time.Duration) ([]conformance.Spec, error) {
+	l.calls++
+	return testSpecs, nil
+}
+
+func (l *fakeLister) ListImages(context.Context, time.Duration) ([]string, error) {
+	l.calls++
+	return []string{"registry.k8s.io/e2e-test-images/agnhost:2.53"}, nil
+}
+
+func TestCache(t *testing.T) {
+	cache := &Cache{Dir: t.TempDir()}
+	lister := &fakeLister{}
+	image := "registry.k8s.io/conformance:v1.33.1"
+
+	_, err := cache.Load(context.Background(), image)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	for range 2 {
+		specs, err := cache.Specs(context.Background(), image, lister, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, testSpecs, specs)
+
+		images, err := cache.Images(context.Background(), image, lister, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"registry.k8s.io/e2e-test-images/agnhost:2.53"}, images)
+	}
+
+	assert.Equal(t, 2, lister.calls)
+
+	_, err = cache.Specs(context.Background(), "registry.example.com:5000/conformance@sha256:0123", lister, time.Minute)
+	require.NoError(t, err)
+
+	catalogs, err := cache.List()
+	require.NoError(t, err)
+	require.Len(t, catalogs, 2)
+	assert.Equal(t, "registry.example.com:5000/conformance@sha256:0123", catalogs[0].Image)
+	assert.Nil(t, catalogs[0].Images)
+	assert.Equal(t, image, catalogs[1].Image)
+
+	require.NoError(t, cache.Remove(context.Background(), image))
+	_, err = cache.Load(context.Background(), image)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	require.NoError(t, cache.Clear())
+	catalogs, err = cache.List()
+	require.NoError(t, err)
+	assert.Empty(t, catalogs)
+}
+
+func TestCacheKeyedByDigest(t *testing.T) {
+	digest := "sha256:aaaa"
+	cache := &Cache{
+		Dir: t.TempDir(),
+		Resolve: func(context.Context, string) (string, error) {
+			if digest == "" {
+				return "", errors.New("registry unreachable")
+			}
+
+			return digest, nil
+		},
+	}
+	lister := &fakeLister{}
+	image := "registry.k8s.io/conformance:v1.35.1"
+
+	_, err := cache.Specs(context.Background(), image, lister, time.Minute)
+	require.NoError(t, err)
+
+	cached, err := cache.Lookup(context.Background(), image)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:aaaa", cached.Digest)
+
+	// the same digest by another reference
+	_, err = cache.Lookup(context.Background(), "registry.k8s.io/conformance@sha256:aaaa")
+	require.NoError(t, err)
+
+	// the tag was pushed again
+	digest = "sha256:bbbb"
+	_, err = cache.Lookup(context.Background(), image)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = cache.Specs(context.Background(), image, lister, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 2, lister.calls)
+
+	// without the registry, the catalog is keyed by reference
+	digest = ""
+	_, err = cache.Specs(context.Background(), image, lister, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 3, lister.calls)
+
+	loaded, err := cache.Load(context.Background(), image)
+	require.NoError(t, err)
+	assert.Empty(t, loaded.Digest)
+
+	// with the registry, the catalog of the current digest is loaded
+	digest = "sha256:bbbb"
+	loaded, err = cache.Load(context.Background(), image)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:bbbb", loaded.Digest)
+
+	catalogs, err := cache.List()
+	require.NoError(t, err)
+	assert.Len(t, catalogs, 3)
+
+	// removing another reference of a digest removes its catalog
+	require.NoError(t, cache.Remove(context.Background(), "registry.k8s.io/conformance:latest"))
+	catalogs, err = cache.List()
+	require.NoError(t, err)
+	assert.Len(t, catalogs, 2)
+
+	require.NoError(t, cache.Remove(context.Background(), image))
+	catalogs, err = cache.List()
+	require.NoError(t, err)
+	assert.Empty(t, catalogs)
+}
+
+func TestFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		focus    string
+		skip     string
+		expected []string
+	}{
+		{
+			name:     "all",
+			expected: []string{testSpecs[0].Name, testSpecs[1].Name, testSpecs[2].Name},
+		},
+		{
+			name:     "conformance",
+			focus:    `\[Conformance\]`,
+			expected: []string{testSpecs[0].Name, testSpecs[1].Name},
+		},
+		{
+			name:     "skip serial",
+			focus:    `\[Conformance\]`,
+			skip:     `\[Serial\]`,
+			expected: []string{testSpecs[0].Name},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			specs, err := Filter(testSpecs, tc.focus, tc.skip)
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, spec := range specs {
+				names = append(names, spec.Name)
+			}
+
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+
+	_, err := Filter(testSpecs, "[", "")
+	assert.Error(t, err)
+}
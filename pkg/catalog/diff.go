@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+)
+
+// Diff is the difference between the catalogs of two conformance images.
+type Diff struct {
+	FromImage string `json:"fromImage" yaml:"fromImage"`
+	ToImage   string `json:"toImage" yaml:"toImage"`
+	// AddedSpecs and RemovedSpecs are the names of specs that only exist in one
+	// of the images. A renamed spec shows up in both.
+	AddedSpecs   []string `json:"addedSpecs" yaml:"addedSpecs"`
+	RemovedSpecs []string `json:"removedSpecs" yaml:"removedSpecs"`
+	// AddedImages and RemovedImages are test images only used by one of the images,
+	// which are not paired up as a change of tag.
+	AddedImages   []string `json:"addedImages" yaml:"addedImages"`
+	RemovedImages []string `json:"removedImages" yaml:"removedImages"`
+	// ChangedImages are test images whose tag changed. If a repository is used with
+	// several tags, the differing tags are paired up in sorted order.
+	ChangedImages []ImageChange `json:"changedImages" yaml:"changedImages"`
+}
+
+// ImageChange describes a test image whose tag differs between two conformance images.
+type ImageChange struct {
+	From string `json:"from" yaml:"from"`
+	To   string `json:"to" yaml:"to"`
+}
+
+// Compare reports how the specs and test images changed from one catalog to
+// another. Both catalogs must contain specs and images.
+func Compare(from, to *Catalog) *Diff {
+	diff := &Diff{
+		FromImage:     from.Image,
+		ToImage:       to.Image,
+		AddedSpecs:    []string{},
+		RemovedSpecs:  []string{},
+		AddedImages:   []string{},
+		RemovedImages: []string{},
+		ChangedImages: []ImageChange{},
+	}
+
+	fromSpecs := specNames(from.Specs)
+	toSpecs := specNames(to.Specs)
+
+	for _, name := range toSpecs {
+		if _, found := slices.BinarySearch(fromSpecs, name); !found {
+			diff.AddedSpecs = append(diff.AddedSpecs, name)
+		}
+	}
+
+	for _, name := range fromSpecs {
+		if _, found := slices.BinarySearch(toSpecs, name); !found {
+			diff.RemovedSpecs = append(diff.RemovedSpecs, name)
+		}
+	}
+
+	fromImages := imagesByRepository(from.Images)
+	toImages := imagesByRepository(to.Images)
+
+	repos := slices.Collect(maps.Keys(toImages))
+	for repo := range fromImages {
+		if _, exists := toImages[repo]; !exists {
+			repos = append(repos, repo)
+		}
+	}
+
+	slices.Sort(repos)
+
+	for _, repo := range repos {
+		removed := without(fromImages[repo], toImages[repo])
+		added := without(toImages[repo], fromImages[repo])
+
+		for len(removed) > 0 && len(added) > 0 {
+			diff.ChangedImages = append(diff.ChangedImages, ImageChange{From: removed[0], To: added[0]})
+			removed, added = removed[1:], added[1:]
+		}
+
+		diff.AddedImages = append(diff.AddedImages, added...)
+		diff.RemovedImages = append(diff.RemovedImages, removed...)
+	}
+
+	return diff
+}
+
+// Print logs the diff in a human-readable form.
+func (d *Diff) Print() {
+	log.Printf("Comparing %s to %s: %d specs added, %d removed; %d test images added, %d removed, %d changed.",
+		d.FromImage, d.ToImage, len(d.AddedSpecs), len(d.RemovedSpecs), len(d.AddedImages), len(d.RemovedImages), len(d.ChangedImages))
+
+	printList("Added specs:", d.AddedSpecs)
+	printList("Removed specs:", d.RemovedSpecs)
+	printList("Added test images:", d.AddedImages)
+	printList("Removed test images:", d.RemovedImages)
+
+	if len(d.ChangedImages) > 0 {
+		log.Println("Changed test images:")
+		for _, change := range d.ChangedImages {
+			log.Printf("  %s -> %s", change.From, change.To)
+		}
+	}
+}
+
+// printList logs a titled list, unless it is empty.
+func printList(title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	log.Println(title)
+	for _, item := range items {
+		log.Printf("  %s", item)
+	}
+}
+
+// specNames returns the sorted names of the specs.
+func specNames(specs []conformance.Spec) []string {
+	names := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// imagesByRepository maps the repository of every image to the sorted images
+// using it, as a repository may be used with several tags.
+func imagesByRepository(images []string) map[string][]string {
+	repos := map[string][]string{}
+	for _, image := range images {
+		image = strings.TrimSpace(image)
+		if image != "" && !slices.Contains(repos[repository(image)], image) {
+			repos[repository(image)] = append(repos[repository(image)], image)
+		}
+	}
+
+	for _, list := range repos {
+		slices.Sort(list)
+	}
+
+	return repos
+}
+
+// without returns the images that are not in the excluded ones.
+func without(images, excluded []string) []string {
+	return slices.DeleteFunc(slices.Clone(images), func(image string) bool { return slices.Contains(excluded, image) })
+}
+
+// repository returns the registry and repository of the image reference, without
+// its tag or digest. Invalid references are their own repository.
+func repository(image string) string {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return image
+	}
+
+	return ref.Registry + "/" + ref.Repository
+}
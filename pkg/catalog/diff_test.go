@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	from := &Catalog{
+		Image: "registry.k8s.io/conformance:v1.34.0",
+		Specs: []conformance.Spec{
+			{Name: "[sig-apps] Deployment should work [Conformance]"},
+			{Name: "[sig-node] Pods should be evicted [Conformance]"},
+		},
+		Images: []string{
+			"",
+			"registry.k8s.io/e2e-test-images/agnhost:2.52",
+			"registry.k8s.io/e2e-test-images/busybox:1.36.1-1",
+			"registry.k8s.io/e2e-test-images/nginx:1.14-4",
+		},
+	}
+
+	to := &Catalog{
+		Image: "registry.k8s.io/conformance:v1.35.0",
+		Specs: []conformance.Spec{
+			{Name: "[sig-apps] Deployment should work [Conformance]"},
+			{Name: "[sig-node] Pods should be evicted gracefully [Conformance]"},
+		},
+		Images: []string{
+			"registry.k8s.io/e2e-test-images/agnhost:2.53",
+			"registry.k8s.io/e2e-test-images/busybox:1.36.1-1",
+			"localhost:5000/e2e-test-images/httpd:2.4.39-4",
+		},
+	}
+
+	assert.Equal(t, &Diff{
+		FromImage:     "registry.k8s.io/conformance:v1.34.0",
+		ToImage:       "registry.k8s.io/conformance:v1.35.0",
+		AddedSpecs:    []string{"[sig-node] Pods should be evicted gracefully [Conformance]"},
+		RemovedSpecs:  []string{"[sig-node] Pods should be evicted [Conformance]"},
+		AddedImages:   []string{"localhost:5000/e2e-test-images/httpd:2.4.39-4"},
+		RemovedImages: []string{"registry.k8s.io/e2e-test-images/nginx:1.14-4"},
+		ChangedImages: []ImageChange{{
+			From: "registry.k8s.io/e2e-test-images/agnhost:2.52",
+			To:   "registry.k8s.io/e2e-test-images/agnhost:2.53",
+		}},
+	}, Compare(from, to))
+}
+
+func TestCompareRepositoryWithSeveralTags(t *testing.T) {
+	from := &Catalog{
+		Image: "registry.k8s.io/conformance:v1.34.0",
+		Images: []string{
+			"registry.k8s.io/e2e-test-images/agnhost:2.52",
+			"registry.k8s.io/e2e-test-images/agnhost:2.39",
+			"registry.k8s.io/e2e-test-images/nginx:1.14-4",
+			"registry.k8s.io/e2e-test-images/nginx:1.15-4",
+			"registry.k8s.io/e2e-test-images/httpd:2.4.38-4",
+		},
+	}
+
+	to := &Catalog{
+		Image: "registry.k8s.io/conformance:v1.35.0",
+		Images: []string{
+			"registry.k8s.io/e2e-test-images/agnhost:2.39",
+			"registry.k8s.io/e2e-test-images/agnhost:2.52",
+			"registry.k8s.io/e2e-test-images/nginx:1.15-4",
+			"registry.k8s.io/e2e-test-images/httpd:2.4.38-4",
+			"registry.k8s.io/e2e-test-images/httpd:2.4.39-4",
+		},
+	}
+
+	// unchanged sets of tags are not reported, regardless of their order
+	assert.Equal(t, &Diff{
+		FromImage:     "registry.k8s.io/conformance:v1.34.0",
+		ToImage:       "registry.k8s.io/conformance:v1.35.0",
+		AddedSpecs:    []string{},
+		RemovedSpecs:  []string{},
+		AddedImages:   []string{"registry.k8s.io/e2e-test-images/httpd:2.4.39-4"},
+		RemovedImages: []string{"registry.k8s.io/e2e-test-images/nginx:1.14-4"},
+		ChangedImages: []ImageChange{},
+	}, Compare(from, to))
+
+	to.Images[0] = "registry.k8s.io/e2e-test-images/agnhost:2.40"
+	assert.Equal(t, []ImageChange{{
+		From: "registry.k8s.io/e2e-test-images/agnhost:2.39",
+		To:   "registry.k8s.io/e2e-test-images/agnhost:2.40",
+	}}, Compare(from, to).ChangedImages)
+}
+
+func TestRepository(t *testing.T) {
+	assert.Equal(t, "registry.k8s.io/e2e-test-images/agnhost", repository("registry.k8s.io/e2e-test-images/agnhost:2.53"))
+	assert.Equal(t, "localhost:5000/agnhost", repository("localhost:5000/agnhost"))
+	assert.Equal(t, "localhost:5000/agnhost", repository("localhost:5000/agnhost:2.53@sha256:0123"))
+	assert.Equal(t, "registry.example.com:5000/team/agnhost", repository("registry.example.com:5000/team/agnhost@sha256:0123"))
+	assert.Equal(t, "docker.io/library/busybox", repository("busybox:1.36"))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"slices"
 	"time"
 
@@ -90,13 +91,91 @@ func CreatePod(ctx context.Context, cs *kubernetes.Clientset, pod *corev1.Pod, t
 	}
 }
 
+// RunPod creates a new Pod, waits for it to terminate and returns the logs of the
+// given container. The Pod is deleted afterwards.
+func RunPod(ctx context.Context, cs *kubernetes.Clientset, pod *corev1.Pod, container string, timeout time.Duration) ([]byte, error) {
+	created, err := CreatePod(ctx, cs, pod, timeout)
+	defer func() {
+		if created != nil {
+			err := cs.CoreV1().Pods(created.Namespace).Delete(ctx, created.Name, metav1.DeleteOptions{})
+			if err != nil {
+				log.Errorf("Failed to delete Pod: %v.", err)
+			}
+		}
+	}()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Pod: %w", err)
+	}
+
+	// Watch for pod events
+	watcher, err := cs.CoreV1().Pods(created.Namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector: "metadata.name=" + created.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch Pod events: %w", err)
+	}
+	defer watcher.Stop()
+
+	log.Println("Waiting for Pod to complete...")
+
+	for {
+		select {
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, errors.New("watching Pod events stopped unexpectedly")
+			}
+
+			pod, ok := event.Object.(*corev1.Pod)
+			if !ok {
+				continue
+			}
+
+			if err := CheckFailedPod(pod); err != nil {
+				return nil, err
+			}
+
+			// Check if the pod is in a terminal state
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				log.Printf("Pod completed: %s", pod.Status.Phase)
+
+				return podLogs(ctx, cs, pod, container)
+			}
+
+		case <-time.After(2 * time.Second):
+			// Check status every 2 seconds
+		}
+	}
+}
+
+// podLogs returns the complete logs of a container.
+func podLogs(ctx context.Context, cs *kubernetes.Clientset, pod *corev1.Pod, container string) ([]byte, error) {
+	req := cs.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+	})
+
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Pod logs: %w", err)
+	}
+	defer stream.Close()
+
+	logs, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Pod logs: %w", err)
+	}
+
+	return logs, nil
+}
+
 // containerErrorReasons is a list of possible reasons a container in a Pod can have.
 // If a container has this status, CreatePod() considers it fails and aborts.
 var containerErrorReasons = []string{"ErrImagePull", "ImagePullBackOff", "Error", "CrashLoopBackOff"}
 
 // CheckFailedPod examines container statuses to detect pod failure conditions
 func CheckFailedPod(pod *corev1.Pod) error {
-	for _, cs := range pod.Status.ContainerStatuses {
+	statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+
+	for _, cs := range statuses {
 		if s := cs.State.Waiting; s != nil && slices.Contains(containerErrorReasons, s.Reason) {
 			return errors.New(s.Message)
 		}
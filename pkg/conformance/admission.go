@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// scratchNamespacePrefix is the prefix of the namespaces representative pods
+	// are admitted in.
+	scratchNamespacePrefix = "hydrophone-preflight-"
+
+	// serviceAccountTimeout is the maximum time to wait for the default ServiceAccount
+	// of a scratch namespace, which pods cannot be admitted without.
+	serviceAccountTimeout = 30 * time.Second
+
+	// podSecurityEnforceLabel is the namespace label selecting the enforced Pod Security level.
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+)
+
+// defaultClassAnnotations mark the default StorageClass.
+var defaultClassAnnotations = []string{"storageclass.kubernetes.io/is-default-class", "storageclass.beta.kubernetes.io/is-default-class"}
+
+// podSecurityViolation matches the Pod Security level in the error of a rejected pod.
+var podSecurityViolation = regexp.MustCompile(`violates PodSecurity "([^"]+)"`)
+
+// CheckAdmission checks the storage, quota and admission settings new namespaces
+// get. Representative pods are created with dry-run in two scratch namespaces: one
+// without labels, like the namespace of the conformance pod, and one labeled
+// privileged, like the namespaces of specs needing privileged pods. The scratch
+// namespaces are removed afterwards.
+func (r *TestRunner) CheckAdmission(ctx context.Context) ([]HealthCheck, error) {
+	storageClasses, err := r.clientset.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list StorageClasses: %w", err)
+	}
+
+	checks := []HealthCheck{checkStorageClasses(storageClasses.Items)}
+
+	unlabeled, err := r.createScratchNamespace(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.deleteScratchNamespace(context.WithoutCancel(ctx), unlabeled)
+
+	privileged, err := r.createScratchNamespace(ctx, map[string]string{podSecurityEnforceLabel: "privileged"})
+	if err != nil {
+		return nil, err
+	}
+	defer r.deleteScratchNamespace(context.WithoutCancel(ctx), privileged)
+
+	conformancePod := r.conformancePod(Phase{Name: "preflight"}, Shard{PodName: "hydrophone-preflight-conformance"}, false)
+	// the ServiceAccount of the conformance pod only exists in its own namespace
+	conformancePod.Spec.ServiceAccountName = ""
+
+	_, err = r.dryRunPod(ctx, unlabeled, conformancePod)
+	checks = append(checks, checkConformancePod(err))
+
+	_, err = r.dryRunPod(ctx, unlabeled, r.scratchPod("hydrophone-preflight-plain", false))
+	checks = append(checks, checkPodSecurity(err))
+
+	_, err = r.dryRunPod(ctx, privileged, r.scratchPod("hydrophone-preflight-privileged", true))
+	checks = append(checks, checkPrivilegedPod(err))
+
+	plain, err := r.dryRunPod(ctx, privileged, r.scratchPod("hydrophone-preflight-plain", false))
+	if err != nil {
+		checks = append(checks, HealthCheck{Name: "pod admission", Status: HealthFail, Message: "pods like the ones most specs create are rejected: " + err.Error()})
+	} else {
+		checks = append(checks, HealthCheck{Name: "pod admission", Status: HealthPass, Message: "pods without resource requests are admitted"})
+	}
+
+	limitRanges, err := r.clientset.CoreV1().LimitRanges(privileged).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LimitRanges: %w", err)
+	}
+
+	checks = append(checks, checkLimitRanges(limitRanges.Items, plain))
+
+	quotas, err := r.clientset.CoreV1().ResourceQuotas(privileged).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ResourceQuotas: %w", err)
+	}
+
+	checks = append(checks, checkResourceQuotas(quotas.Items))
+
+	return checks, nil
+}
+
+// createScratchNamespace creates a namespace with the given labels and waits for
+// its default ServiceAccount. It returns the generated name of the namespace.
+func (r *TestRunner) createScratchNamespace(ctx context.Context, labels map[string]string) (string, error) {
+	ns, err := r.clientset.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: scratchNamespacePrefix,
+			Labels:       labels,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create scratch namespace: %w", err)
+	}
+
+	err = wait.PollUntilContextTimeout(ctx, time.Second, serviceAccountTimeout, true, func(ctx context.Context) (bool, error) {
+		_, err := r.clientset.CoreV1().ServiceAccounts(ns.Name).Get(ctx, "default", metav1.GetOptions{})
+		return err == nil, nil
+	})
+	if err != nil {
+		log.Warnf("Default ServiceAccount of namespace %s was not created within %v.", ns.Name, serviceAccountTimeout)
+	}
+
+	return ns.Name, nil
+}
+
+// deleteScratchNamespace removes a scratch namespace without waiting for it.
+func (r *TestRunner) deleteScratchNamespace(ctx context.Context, name string) {
+	if err := r.clientset.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		log.Errorf("Failed to delete scratch namespace %s: %v", name, err)
+	}
+}
+
+// dryRunPod creates the pod in the namespace without persisting it and returns
+// the pod as admitted, including the changes of mutating admission.
+func (r *TestRunner) dryRunPod(ctx context.Context, namespace string, pod *corev1.Pod) (*corev1.Pod, error) {
+	pod.Namespace = namespace
+
+	return r.clientset.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+}
+
+// scratchPod returns a busybox pod without resource requests or security context,
+// optionally privileged and in the host network.
+func (r *TestRunner) scratchPod(name string, privileged bool) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "busybox",
+					Image:   r.config.PinnedBusyboxImage(),
+					Command: []string{"/bin/sh", "-c", "sleep infinity"},
+				},
+			},
+		},
+	}
+
+	if privileged {
+		pod.Spec.HostNetwork = true
+		pod.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{Privileged: ptr.To(true)}
+	}
+
+	return pod
+}
+
+// checkStorageClasses checks that a default StorageClass exists.
+func checkStorageClasses(storageClasses []storagev1.StorageClass) HealthCheck {
+	defaults := []string{}
+	for _, sc := range storageClasses {
+		if slices.ContainsFunc(defaultClassAnnotations, func(annotation string) bool { return sc.Annotations[annotation] == "true" }) {
+			defaults = append(defaults, sc.Name)
+		}
+	}
+
+	if len(defaults) == 0 {
+		return HealthCheck{Name: "default StorageClass", Status: HealthWarn, Message: "none, specs provisioning volumes dynamically (e.g. [sig-storage] and StatefulSet specs) will fail"}
+	}
+
+	return HealthCheck{Name: "default StorageClass", Status: HealthPass, Message: strings.Join(defaults, ", ")}
+}
+
+// checkConformancePod checks the outcome of admitting the conformance pod in a
+// namespace without labels.
+func checkConformancePod(err error) HealthCheck {
+	if err != nil {
+		return HealthCheck{Name: "conformance pod admission", Status: HealthFail, Message: "the conformance pod is rejected: " + err.Error()}
+	}
+
+	return HealthCheck{Name: "conformance pod admission", Status: HealthPass, Message: "admitted"}
+}
+
+// checkPodSecurity checks the outcome of admitting a pod violating the restricted
+// Pod Security level in a namespace without labels.
+func checkPodSecurity(err error) HealthCheck {
+	check := HealthCheck{Name: "pod security default", Status: HealthPass, Message: "namespaces without labels admit pods violating the restricted level"}
+
+	if match := podSecurityViolation.FindStringSubmatch(fmt.Sprint(err)); match != nil {
+		check.Status, check.Message = HealthWarn, fmt.Sprintf("%s is enforced in namespaces without labels, specs creating pods in namespaces they do not label themselves will fail", match[1])
+	} else if err != nil {
+		check.Status, check.Message = HealthWarn, "pods violating the restricted level are rejected in namespaces without labels: "+err.Error()
+	}
+
+	return check
+}
+
+// checkPrivilegedPod checks the outcome of admitting a privileged pod in a
+// namespace labeled privileged.
+func checkPrivilegedPod(err error) HealthCheck {
+	if err != nil {
+		return HealthCheck{Name: "privileged pods", Status: HealthWarn, Message: "rejected in namespaces labeled privileged, specs running privileged or host network pods (e.g. [sig-node] and [sig-network] specs) will fail: " + err.Error()}
+	}
+
+	return HealthCheck{Name: "privileged pods", Status: HealthPass, Message: "admitted in namespaces labeled privileged"}
+}
+
+// checkLimitRanges checks the LimitRanges new namespaces get. The admitted pod
+// shows whether defaults are injected into pods without resource requests.
+func checkLimitRanges(limitRanges []corev1.LimitRange, admitted *corev1.Pod) HealthCheck {
+	defaulted := admitted != nil && slices.ContainsFunc(admitted.Spec.Containers, func(c corev1.Container) bool {
+		return len(c.Resources.Requests) > 0 || len(c.Resources.Limits) > 0
+	})
+
+	if len(limitRanges) == 0 && !defaulted {
+		return HealthCheck{Name: "LimitRange", Status: HealthPass, Message: "none in new namespaces"}
+	}
+
+	names := []string{}
+	for _, lr := range limitRanges {
+		names = append(names, lr.Name)
+	}
+
+	message := "resource requirements are defaulted for pods without them"
+	if len(names) > 0 {
+		message = fmt.Sprintf("%s in new namespaces, specs whose pods exceed the limits are rejected", strings.Join(names, ", "))
+	}
+
+	if defaulted {
+		message += ", defaults may make resource-heavy specs unschedulable or OOM-killed"
+	}
+
+	return HealthCheck{Name: "LimitRange", Status: HealthWarn, Message: message}
+}
+
+// checkResourceQuotas checks the ResourceQuotas new namespaces get.
+func checkResourceQuotas(quotas []corev1.ResourceQuota) HealthCheck {
+	if len(quotas) == 0 {
+		return HealthCheck{Name: "ResourceQuota", Status: HealthPass, Message: "none in new namespaces"}
+	}
+
+	hard := []string{}
+	for _, quota := range quotas {
+		for _, name := range slices.Sorted(maps.Keys(quota.Spec.Hard)) {
+			value := quota.Spec.Hard[name]
+			hard = append(hard, fmt.Sprintf("%s=%s", name, value.String()))
+		}
+	}
+
+	return HealthCheck{Name: "ResourceQuota", Status: HealthWarn, Message: fmt.Sprintf("%s in new namespaces, specs exceeding it (e.g. scaling workloads, creating many objects) will fail", strings.Join(hard, ", "))}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCheckStorageClasses(t *testing.T) {
+	standard := storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "standard"}}
+	assert.Equal(t, HealthWarn, checkStorageClasses([]storagev1.StorageClass{standard}).Status)
+
+	standard.Annotations = map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}
+	assert.Equal(t, HealthCheck{Name: "default StorageClass", Status: HealthPass, Message: "standard"}, checkStorageClasses([]storagev1.StorageClass{standard}))
+}
+
+func TestCheckPodSecurity(t *testing.T) {
+	assert.Equal(t, HealthPass, checkPodSecurity(nil).Status)
+
+	check := checkPodSecurity(errors.New(`pods "hydrophone-preflight-plain" is forbidden: violates PodSecurity "restricted:latest": allowPrivilegeEscalation != false`))
+	assert.Equal(t, HealthWarn, check.Status)
+	assert.Contains(t, check.Message, "restricted:latest is enforced in namespaces without labels")
+
+	check = checkPodSecurity(errors.New(`admission webhook "validate.kyverno.svc" denied the request`))
+	assert.Equal(t, HealthWarn, check.Status)
+	assert.Contains(t, check.Message, "kyverno")
+}
+
+func TestCheckLimitRanges(t *testing.T) {
+	admitted := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "busybox"}}}}
+	assert.Equal(t, HealthPass, checkLimitRanges(nil, admitted).Status)
+	assert.Equal(t, HealthPass, checkLimitRanges(nil, nil).Status)
+
+	admitted.Spec.Containers[0].Resources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}
+	check := checkLimitRanges([]corev1.LimitRange{{ObjectMeta: metav1.ObjectMeta{Name: "defaults"}}}, admitted)
+	assert.Equal(t, HealthWarn, check.Status)
+	assert.Equal(t, "defaults in new namespaces, specs whose pods exceed the limits are rejected, defaults may make resource-heavy specs unschedulable or OOM-killed", check.Message)
+}
+
+func TestCheckResourceQuotas(t *testing.T) {
+	assert.Equal(t, HealthPass, checkResourceQuotas(nil).Status)
+
+	check := checkResourceQuotas([]corev1.ResourceQuota{{
+		Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+			corev1.ResourcePods:        resource.MustParse("10"),
+			corev1.ResourceRequestsCPU: resource.MustParse("2"),
+		}},
+	}})
+	assert.Equal(t, HealthWarn, check.Status)
+	assert.Contains(t, check.Message, "pods=10, requests.cpu=2 in new namespaces")
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"sigs.k8s.io/hydrophone/pkg/conformance"
 	"sigs.k8s.io/hydrophone/pkg/types"
 
 	"k8s.io/client-go/kubernetes"
@@ -28,6 +29,7 @@ type Client struct {
 	config        *rest.Config
 	clientset     *kubernetes.Clientset
 	namespace     string
+	podNames      []string
 	configuration *types.Configuration
 }
 
@@ -37,6 +39,16 @@ func NewClient(config *rest.Config, clientset *kubernetes.Clientset, namespace s
 		config:        config,
 		clientset:     clientset,
 		namespace:     namespace,
+		podNames:      []string{conformance.PodName},
 		configuration: configuration,
 	}
 }
+
+// ForPods returns a copy of the client that interacts with the given conformance pods
+// instead of the default one.
+func (c *Client) ForPods(podNames ...string) *Client {
+	clone := *c
+	clone.podNames = podNames
+
+	return &clone
+}
@@ -17,40 +17,62 @@ limitations under the License.
 package client
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 
+	"sigs.k8s.io/hydrophone/pkg/common"
 	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/junit"
 	"sigs.k8s.io/hydrophone/pkg/log"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/tools/remotecommand"
-	"k8s.io/streaming/pkg/httpstream"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// FetchFiles downloads the e2e.log and junit_01.xml files from the pod
-// and writes them to the output directory.
+// FetchFiles downloads the e2e.log and junit_01.xml files from the pods
+// and writes them to the output directory. The files of multiple pods are
+// downloaded into a subdirectory per pod and then merged.
 func (c *Client) FetchFiles(ctx context.Context, outputDir string) error {
-	if err := c.fetchFile(ctx, outputDir, "e2e.log"); err != nil {
+	if len(c.podNames) == 1 {
+		return c.fetchFiles(ctx, c.podNames[0], outputDir)
+	}
+
+	podDirs := []string{}
+
+	for _, podName := range c.podNames {
+		podDir := filepath.Join(outputDir, podName)
+		if err := os.MkdirAll(podDir, 0o755); err != nil {
+			return err
+		}
+
+		if err := c.fetchFiles(ctx, podName, podDir); err != nil {
+			return err
+		}
+
+		podDirs = append(podDirs, podDir)
+	}
+
+	return MergeFiles(outputDir, podDirs)
+}
+
+// fetchFiles downloads the result files of a single pod.
+func (c *Client) fetchFiles(ctx context.Context, podName, outputDir string) error {
+	if err := c.fetchFile(ctx, podName, outputDir, "e2e.log"); err != nil {
 		return err
 	}
 
-	if err := c.fetchFile(ctx, outputDir, "junit_01.xml"); err != nil {
+	if err := c.fetchFile(ctx, podName, outputDir, "junit_01.xml"); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// FetchFiles downloads a single file from the output container to the local machine.
-func (c *Client) fetchFile(ctx context.Context, outputDir, filename string) error {
+// fetchFile downloads a single file from the output container to the local machine.
+func (c *Client) fetchFile(ctx context.Context, podName, outputDir, filename string) error {
 	dest := filepath.Join(outputDir, filename)
 	log.Printf("Downloading %s to %s...", filename, dest)
 
@@ -62,61 +84,49 @@ func (c *Client) fetchFile(ctx context.Context, outputDir, filename string) erro
 
 	containerFile := "/tmp/results/" + filename
 
-	return c.downloadFile(ctx, conformance.PodName, conformance.OutputContainer, containerFile, localFile)
+	return c.downloadFile(ctx, podName, conformance.OutputContainer, containerFile, localFile)
 }
 
-// downloadFile extracts test results from the container to local output directory
-func (c *Client) downloadFile(ctx context.Context, podName, containerName, filePath string, writer io.Writer) error {
-	// Create an exec request
-	req := c.clientset.CoreV1().RESTClient().Post().
-		Resource("pods").
-		Name(podName).
-		Namespace(c.namespace).
-		SubResource("exec").
-		Param("container", containerName)
-
-	scheme := runtime.NewScheme()
-	if err := corev1.AddToScheme(scheme); err != nil {
+// MergeFiles combines the e2e.log and junit_01.xml files found in each of the
+// given directories into a single set of files in the output directory.
+func MergeFiles(outputDir string, dirs []string) error {
+	logFile, err := os.Create(filepath.Join(outputDir, "e2e.log"))
+	if err != nil {
 		return err
 	}
-	// Configure exec options
-	option := &corev1.PodExecOptions{
-		Stdout:  true,
-		Stderr:  true,
-		Command: []string{"cat", filePath},
-	}
-	parameterCodec := runtime.NewParameterCodec(scheme)
-	req.VersionedParams(option, parameterCodec)
+	defer logFile.Close()
 
-	// Use a fallback executor with WebSocket as primary and SPDY as fallback protocol following KEP-4006
-	websocketExecutor, err := remotecommand.NewWebSocketExecutor(c.config, http.MethodGet, req.URL().String())
-	if err != nil {
-		return fmt.Errorf("failed to initialize the websocket executor: %w", err)
-	}
+	reports := []*junit.TestSuites{}
 
-	spdyExecutor, err := remotecommand.NewSPDYExecutor(c.config, http.MethodPost, req.URL())
-	if err != nil {
-		return fmt.Errorf("failed to initialize the websocket executor: %w", err)
-	}
+	for _, dir := range dirs {
+		logData, err := os.ReadFile(filepath.Join(dir, "e2e.log"))
+		if err != nil {
+			return err
+		}
 
-	executor, err := remotecommand.NewFallbackExecutor(websocketExecutor, spdyExecutor, func(err error) bool {
-		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
-	})
-	if err != nil {
-		return fmt.Errorf("failed to initialize the command executor: %w", err)
+		if _, err := logFile.Write(logData); err != nil {
+			return err
+		}
+
+		report, err := junit.LoadFile(filepath.Join(dir, "junit_01.xml"))
+		if err != nil {
+			return err
+		}
+
+		reports = append(reports, report)
 	}
 
-	// Stream the file content from the container to the writer
-	var stderr bytes.Buffer
+	log.Printf("Merged results of %d runs into %s.", len(dirs), outputDir)
 
-	err = executor.StreamWithContext(
-		ctx,
-		remotecommand.StreamOptions{
-			Stdout: writer,
-			Stderr: &stderr,
-		})
-	if err != nil {
-		return fmt.Errorf("download failed: %w (stderr: %s)", err, strings.TrimSpace(stderr.String()))
+	return junit.WriteFile(filepath.Join(outputDir, "junit_01.xml"), junit.Merge(reports...))
+}
+
+// downloadFile extracts test results from the container to local output directory
+func (c *Client) downloadFile(ctx context.Context, podName, containerName, filePath string, writer io.Writer) error {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: c.namespace}}
+
+	if err := common.ExecStream(ctx, c.config, c.clientset, pod, containerName, []string{"cat", filePath}, writer); err != nil {
+		return fmt.Errorf("download failed: %w", err)
 	}
 
 	return nil
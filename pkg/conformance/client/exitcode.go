@@ -27,11 +27,30 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// FetchExitCode waits for pod to be in terminated state and get the exit code
+// FetchExitCode waits for all conformance pods to be in terminated state and returns
+// the first non-zero exit code, or 0 if all tests succeeded.
 func (c *Client) FetchExitCode(ctx context.Context) (int, error) {
+	result := 0
+
+	for _, podName := range c.podNames {
+		exitCode, err := c.fetchExitCode(ctx, podName)
+		if err != nil {
+			return exitCode, err
+		}
+
+		if result == 0 {
+			result = exitCode
+		}
+	}
+
+	return result, nil
+}
+
+// fetchExitCode waits for pod to be in terminated state and get the exit code
+func (c *Client) fetchExitCode(ctx context.Context, podName string) (int, error) {
 	// Watching the pod's status
 	watchInterface, err := c.clientset.CoreV1().Pods(c.namespace).Watch(ctx, metav1.ListOptions{
-		FieldSelector: fmt.Sprintf("metadata.name=%s", conformance.PodName),
+		FieldSelector: fmt.Sprintf("metadata.name=%s", podName),
 	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to watch Pods: %w", err)
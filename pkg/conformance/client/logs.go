@@ -21,17 +21,22 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"sigs.k8s.io/hydrophone/pkg/conformance"
 	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/types"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/scheme"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/utils/ptr"
@@ -44,7 +49,9 @@ type streamLogs struct {
 	doneCh chan bool
 }
 
-// PrintE2ELogs checks for Pod and starts a goroutine to print the logs in real-time to stdout.
+// PrintE2ELogs checks for the conformance pods and prints their logs in real-time to stdout,
+// or to stderr with --output json, where stdout is reserved for the run summary. When
+// following multiple pods, each line is prefixed with the name of its pod.
 func (c *Client) PrintE2ELogs(ctx context.Context) error {
 	informerFactory := informers.NewSharedInformerFactory(c.clientset, 10*time.Second)
 
@@ -57,10 +64,30 @@ func (c *Client) PrintE2ELogs(ctx context.Context) error {
 	informerFactory.Start(ctx.Done())
 	informerFactory.WaitForCacheSync(ctx.Done())
 
+	if len(c.podNames) == 1 {
+		c.printPodLogs(ctx, podInformer.Lister(), c.podNames[0], "")
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for _, podName := range c.podNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.printPodLogs(ctx, podInformer.Lister(), podName, fmt.Sprintf("[%s] ", podName))
+		}()
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// printPodLogs waits for a conformance pod to run and prints its logs until the tests have finished.
+func (c *Client) printPodLogs(ctx context.Context, lister corev1listers.PodLister, podName, prefix string) {
 	for {
-		pod, err := podInformer.Lister().Pods(c.namespace).Get(conformance.PodName)
+		pod, err := lister.Pods(c.namespace).Get(podName)
 		if err != nil {
-			log.Errorf("Waiting for pod %s/%s to be created: %v", c.namespace, conformance.PodName, err)
+			log.Errorf("Waiting for pod %s/%s to be created: %v", c.namespace, podName, err)
 			time.Sleep(time.Second)
 			continue
 		}
@@ -72,7 +99,7 @@ func (c *Client) PrintE2ELogs(ctx context.Context) error {
 				doneCh: make(chan bool),
 			}
 
-			go c.streamPodLogs(ctx, stream)
+			go c.streamPodLogs(ctx, podName, stream)
 
 		loop:
 			for {
@@ -80,7 +107,7 @@ func (c *Client) PrintE2ELogs(ctx context.Context) error {
 				case err = <-stream.errCh:
 					log.Fatal(err)
 				case logStream := <-stream.logCh:
-					_, err = fmt.Print(logStream)
+					_, err = fmt.Fprint(c.logOutput(), prefix+logStream)
 					if err != nil {
 						log.Fatal(err)
 					}
@@ -88,25 +115,32 @@ func (c *Client) PrintE2ELogs(ctx context.Context) error {
 					break loop
 				}
 			}
-			if c.testsAreStillRunning(ctx) {
+			if c.testsAreStillRunning(ctx, podName) {
 				log.Println("Tests are still running, restarting stream")
 				continue
 			}
 			break
 		}
 	}
+}
+
+// logOutput returns the writer the logs of the tests are printed to.
+func (c *Client) logOutput() io.Writer {
+	if c.configuration.Output == types.OutputJSON {
+		return os.Stderr
+	}
 
-	return nil
+	return os.Stdout
 }
 
 // streamPodLogs continuously reads logs from a conformance pod and forwards them to channels
-func (c *Client) streamPodLogs(ctx context.Context, stream streamLogs) {
+func (c *Client) streamPodLogs(ctx context.Context, podName string, stream streamLogs) {
 	podLogOpts := corev1.PodLogOptions{
 		Container: conformance.ConformanceContainer,
 		Follow:    true,
 	}
 
-	req := c.clientset.CoreV1().Pods(c.namespace).GetLogs(conformance.PodName, &podLogOpts)
+	req := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, &podLogOpts)
 	podLogs, err := req.Stream(ctx)
 	if err != nil {
 		stream.errCh <- err
@@ -116,7 +150,7 @@ func (c *Client) streamPodLogs(ctx context.Context, stream streamLogs) {
 
 	// Start a goroutine to watch test status and provide periodic updates if disable-progress-status flag not set
 	if !c.configuration.DisableProgressStatus {
-		go c.watchStatus(ctx, stream)
+		go c.watchStatus(ctx, podName, stream)
 	}
 
 	reader := bufio.NewScanner(podLogs)
@@ -159,7 +193,7 @@ func parseTestProgress(logOutput string) (int, int, error) {
 }
 
 // watchStatus monitors test progress by periodically checking e2e.log file in the pod
-func (c *Client) watchStatus(ctx context.Context, stream streamLogs) {
+func (c *Client) watchStatus(ctx context.Context, podName string, stream streamLogs) {
 	// Wait a bit for the container to start and create the log file
 	time.Sleep(5 * time.Second)
 
@@ -175,7 +209,7 @@ func (c *Client) watchStatus(ctx context.Context, stream streamLogs) {
 			}
 			req := c.clientset.CoreV1().RESTClient().Post().
 				Resource("pods").
-				Name(conformance.PodName).
+				Name(podName).
 				Namespace(c.namespace).
 				SubResource("exec").
 				Param("container", conformance.ConformanceContainer)
@@ -240,7 +274,7 @@ func (c *Client) watchStatus(ctx context.Context, stream streamLogs) {
 }
 
 // testsAreStillRunning tries to determine whether the ginkgo test suite has completed already. Returns false also in case streaming of logs fails over the period of a minute
-func (c *Client) testsAreStillRunning(ctx context.Context) bool {
+func (c *Client) testsAreStillRunning(ctx context.Context, podName string) bool {
 	reFinishedLine := regexp.MustCompile(`Ginkgo ran (00|[1-9]\d{0,2}) suite`)
 
 	podLogOpts := corev1.PodLogOptions{
@@ -251,7 +285,7 @@ func (c *Client) testsAreStillRunning(ctx context.Context) bool {
 
 	for i := 0; i < 6; i++ {
 		finished, err := func() (bool, error) {
-			req := c.clientset.CoreV1().Pods(c.namespace).GetLogs(conformance.PodName, &podLogOpts)
+			req := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, &podLogOpts)
 			podLogs, err := req.Stream(ctx)
 			if err != nil {
 				return false, err
@@ -17,8 +17,11 @@ limitations under the License.
 package client
 
 import (
+	"os"
 	"testing"
 
+	"sigs.k8s.io/hydrophone/pkg/types"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -242,3 +245,12 @@ func TestParseTestProgressEmpty(t *testing.T) {
 	_, _, err := parseTestProgress("")
 	assert.Error(t, err)
 }
+
+func TestLogOutput(t *testing.T) {
+	config := types.NewDefaultConfiguration()
+	c := NewClient(nil, nil, "conformance", &config)
+	assert.Equal(t, os.Stdout, c.logOutput())
+
+	config.Output = types.OutputJSON
+	assert.Equal(t, os.Stderr, c.logOutput())
+}
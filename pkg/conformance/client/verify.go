@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+	"sigs.k8s.io/hydrophone/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VerifyImages checks that the containers of all conformance pods ran the images
+// with the digests they were pinned to, or with one of their platform digests.
+// Images without a digest are not checked. It returns a description of every
+// container that ran a different image and of every pod that could not be checked.
+func (c *Client) VerifyImages(ctx context.Context) []string {
+	expected := map[string][]string{
+		conformance.ConformanceContainer: acceptedDigests(c.configuration.ConformanceImageDigest, c.configuration.ConformanceImagePlatformDigests),
+		conformance.OutputContainer:      acceptedDigests(c.configuration.BusyboxImageDigest, c.configuration.BusyboxImagePlatformDigests),
+	}
+
+	mismatches := []string{}
+
+	for _, podName := range c.podNames {
+		pod, err := c.clientset.CoreV1().Pods(c.namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			mismatches = append(mismatches, fmt.Sprintf("pod %s: failed to get Pod: %v", podName, err))
+			continue
+		}
+
+		for _, mismatch := range verifyImageIDs(pod.Status.ContainerStatuses, expected) {
+			mismatches = append(mismatches, fmt.Sprintf("pod %s: %s", podName, mismatch))
+		}
+	}
+
+	if len(mismatches) == 0 && len(expected[conformance.ConformanceContainer]) > 0 {
+		log.Printf("Verified that the tests ran conformance image %s.", c.configuration.PinnedConformanceImage())
+	}
+
+	return mismatches
+}
+
+// acceptedDigests returns the digest an image was pinned to followed by its
+// platform digests, or nothing if the image was not pinned.
+func acceptedDigests(digest string, platforms []string) []string {
+	if digest == "" {
+		return nil
+	}
+
+	return append([]string{digest}, platforms...)
+}
+
+// verifyImageIDs compares the digests of the image IDs reported for the containers,
+// like registry.k8s.io/conformance@sha256:..., with the accepted ones and describes
+// every container that ran a different image.
+func verifyImageIDs(statuses []corev1.ContainerStatus, expected map[string][]string) []string {
+	mismatches := []string{}
+
+	for _, status := range statuses {
+		digests := expected[status.Name]
+		if len(digests) == 0 {
+			continue
+		}
+
+		_, actual, _ := strings.Cut(status.ImageID, "@")
+		if !slices.Contains(digests, actual) {
+			mismatches = append(mismatches, fmt.Sprintf("container %s ran image %q instead of digest %s", status.Name, status.ImageID, digests[0]))
+		}
+	}
+
+	return mismatches
+}
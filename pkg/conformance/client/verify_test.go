@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/conformance"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestVerifyImageIDs(t *testing.T) {
+	expected := map[string][]string{
+		conformance.ConformanceContainer: {"sha256:aaaa", "sha256:a1a1", "sha256:a2a2"},
+		conformance.OutputContainer:      nil,
+	}
+
+	statuses := []corev1.ContainerStatus{
+		{Name: conformance.ConformanceContainer, ImageID: "registry.k8s.io/conformance@sha256:aaaa"},
+		{Name: conformance.OutputContainer, ImageID: "sha256:cccc"},
+	}
+	assert.Empty(t, verifyImageIDs(statuses, expected))
+
+	// CRI-O reports the digest of the platform manifest of the pinned index
+	statuses[0].ImageID = "registry.k8s.io/conformance@sha256:a2a2"
+	assert.Empty(t, verifyImageIDs(statuses, expected))
+
+	statuses[0].ImageID = "docker-pullable://registry.k8s.io/conformance@sha256:bbbb"
+	mismatches := verifyImageIDs(statuses, expected)
+	require.Len(t, mismatches, 1)
+	assert.Contains(t, mismatches[0], "instead of digest sha256:aaaa")
+
+	// images loaded into the node without a registry have no repository digest
+	statuses[0].ImageID = "sha256:aaaa"
+	assert.Len(t, verifyImageIDs(statuses, expected), 1)
+}
+
+func TestAcceptedDigests(t *testing.T) {
+	assert.Empty(t, acceptedDigests("", []string{"sha256:a1a1"}))
+	assert.Equal(t, []string{"sha256:aaaa"}, acceptedDigests("sha256:aaaa", nil))
+	assert.Equal(t, []string{"sha256:aaaa", "sha256:a1a1"}, acceptedDigests("sha256:aaaa", []string{"sha256:a1a1"}))
+}
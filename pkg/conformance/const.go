@@ -29,4 +29,6 @@ const (
 	ConformanceContainer = "conformance-container"
 	// OutputContainer is the name of the busybox container
 	OutputContainer = "output-container"
+	// RepoListConfigMapName is the name of the ConfigMap holding the test repo list
+	RepoListConfigMapName = "repo-list-config"
 )
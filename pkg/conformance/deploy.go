@@ -34,8 +34,33 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// Deploy sets up the necessary resources and runs E2E conformance tests.
-func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, verboseGinkgo bool, timeout time.Duration) error {
+// Deploy checks the health of the cluster and the admission of test pods, sets up the
+// necessary resources, probes the pod network and starts the conformance pod for the
+// first test phase. The checks and the network probe are skipped with --skip-preflight.
+// The permissions must have been checked with CheckPermissions beforehand.
+func (r *TestRunner) Deploy(ctx context.Context, phase Phase, skipPreflight string, verboseGinkgo bool, timeout time.Duration) error {
+	if skipPreflight == "" {
+		checks, err := r.CheckHealth(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check cluster health: %w", err)
+		}
+
+		admission, err := r.CheckAdmission(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check admission of test pods: %w", err)
+		}
+
+		checks = append(checks, admission...)
+
+		if err := PrintHealthChecks(os.Stderr, checks); err != nil {
+			return err
+		}
+
+		if HealthFailed(checks) {
+			return fmt.Errorf("cluster is not healthy, fix the failed checks or run with --skip-preflight")
+		}
+	}
+
 	conformanceNS := corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: r.config.Namespace,
@@ -66,7 +91,7 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 				Verbs:     []string{"*"},
 			},
 			{
-				NonResourceURLs: []string{"/metrics", "/logs", "/logs/*"},
+				NonResourceURLs: conformanceURLs,
 				Verbs:           []string{"get"},
 			},
 		},
@@ -93,14 +118,171 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 		},
 	}
 
+	ns, err := r.clientset.CoreV1().Namespaces().Create(ctx, &conformanceNS, metav1.CreateOptions{})
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			if skipPreflight != "" {
+				log.Printf("Using existing namespace: %s", r.config.Namespace)
+			} else {
+				//nolint:stylecheck // error message references a Kubernetes resource type.
+				return fmt.Errorf("namespace %s already exists, please run with --cleanup first", conformanceNS.Name)
+			}
+		} else {
+			return fmt.Errorf("failed to create namespace: %w", err)
+		}
+	} else {
+		log.Printf("Created namespace %s.", ns.Name)
+	}
+
+	sa, err := r.clientset.CoreV1().ServiceAccounts(r.config.Namespace).Create(ctx, &conformanceSA, metav1.CreateOptions{})
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			if skipPreflight != "" {
+				log.Printf("using existing ServiceAccount: %s/%s", r.config.Namespace, ServiceAccountName)
+			} else {
+				return fmt.Errorf("serviceAccount %s already exists, please run --cleanup first", conformanceSA.Name)
+			}
+		} else {
+			return fmt.Errorf("failed to create ServiceAccount: %w", err)
+		}
+	} else {
+		log.Printf("Created ServiceAccount %s.", sa.Name)
+	}
+
+	clusterRole, err := r.clientset.RbacV1().ClusterRoles().Create(ctx, &conformanceClusterRole, metav1.CreateOptions{})
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			if skipPreflight != "" {
+				log.Printf("using existing ClusterRole: %s/%s", r.config.Namespace, r.namespacedName(ClusterRoleName))
+			} else {
+				return fmt.Errorf("clusterRole %s already exists, please run --cleanup first", conformanceClusterRole.Name)
+			}
+		} else {
+			return fmt.Errorf("failed to create ClusterRole: %w", err)
+		}
+	} else {
+		log.Printf("Created ClusterRole %s.", clusterRole.Name)
+	}
+
+	clusterRoleBinding, err := r.clientset.RbacV1().ClusterRoleBindings().Create(ctx, &conformanceClusterRoleBinding, metav1.CreateOptions{})
+	if err != nil {
+		if errors.IsAlreadyExists(err) {
+			if skipPreflight != "" {
+				log.Printf("using existing ClusterRoleBinding: %s/%s", r.config.Namespace, r.namespacedName(ClusterRoleBindingName))
+			} else {
+				return fmt.Errorf("clusterRoleBinding %s already exists, please run --cleanup first", conformanceClusterRoleBinding.Name)
+			}
+		} else {
+			return fmt.Errorf("failed to create ClusterRoleBinding: %w", err)
+		}
+	} else {
+		log.Printf("Created ClusterRoleBinding %s.", clusterRoleBinding.Name)
+	}
+
+	if filename := r.config.TestRepoList; filename != "" {
+		repoListData, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("failed to read repo list: %w", err)
+		}
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      RepoListConfigMapName,
+				Namespace: r.config.Namespace,
+			},
+			Data: map[string]string{
+				"repo-list.yaml": string(repoListData),
+			},
+		}
+
+		cm, err := r.clientset.CoreV1().ConfigMaps(r.config.Namespace).Create(ctx, configMap, metav1.CreateOptions{})
+		if err != nil {
+			if errors.IsAlreadyExists(err) {
+				err = fmt.Errorf("configMap %s already exists, please run --cleanup first", configMap.Name)
+			}
+
+			return err
+		}
+		log.Printf("Created ConfigMap %s.", cm.Name)
+	}
+
+	if r.config.PrepullImages {
+		if err := r.PrepullImages(ctx, timeout); err != nil {
+			return fmt.Errorf("failed to pre-pull images: %w", err)
+		}
+	}
+
+	if skipPreflight == "" {
+		results, err := r.ProbeNetwork(ctx, timeout)
+		if err != nil {
+			return fmt.Errorf("failed to probe the pod network: %w", err)
+		}
+
+		if err := PrintProbeResults(os.Stderr, results); err != nil {
+			return err
+		}
+
+		if ProbeFailed(results) {
+			return fmt.Errorf("pod networking or DNS is broken, fix the failed probes or run with --skip-preflight")
+		}
+	}
+
+	return r.DeployPhase(ctx, phase, skipPreflight, verboseGinkgo, timeout)
+}
+
+// DeployPhase starts the conformance pods for a single test phase. The namespace, RBAC
+// resources and ConfigMaps must have been created by Deploy beforehand.
+func (r *TestRunner) DeployPhase(ctx context.Context, phase Phase, skipPreflight string, verboseGinkgo bool, timeout time.Duration) error {
+	log.Printf("Starting %s test phase.", phase.Name)
+
+	for _, shard := range phase.Shards {
+		conformancePod := r.conformancePod(phase, shard, verboseGinkgo)
+
+		pod, err := common.CreatePod(ctx, r.clientset, conformancePod, timeout)
+		if err != nil {
+			if errors.IsAlreadyExists(err) {
+				if skipPreflight != "" {
+					log.Printf("using existing Pod: %s/%s", r.config.Namespace, conformancePod.Name)
+				} else {
+					return fmt.Errorf("pod %s already exists, please run --cleanup first", conformancePod.Name)
+				}
+			} else {
+				return fmt.Errorf("failed to create Pod: %w", err)
+			}
+		} else {
+			log.Printf("Created ConformancePod %s.", pod.Name)
+		}
+	}
+
+	return nil
+}
+
+// IsDeployed returns true if all conformance pods for the given phase exist already.
+func (r *TestRunner) IsDeployed(ctx context.Context, phase Phase) (bool, error) {
+	for _, shard := range phase.Shards {
+		_, err := r.clientset.CoreV1().Pods(r.config.Namespace).Get(ctx, shard.PodName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("failed to get Pod: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// conformancePod returns the definition of the pod running a shard of the given test phase.
+func (r *TestRunner) conformancePod(phase Phase, shard Shard, verboseGinkgo bool) *corev1.Pod {
 	containerEnv := []corev1.EnvVar{
 		{
 			Name:  "E2E_FOCUS",
-			Value: focus,
+			Value: shard.Focus,
 		},
 		{
 			Name:  "E2E_SKIP",
-			Value: r.config.Skip,
+			Value: phase.Skip,
 		},
 		{
 			Name:  "E2E_PROVIDER",
@@ -120,19 +302,9 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 		},
 	}
 
-	extraGinkgoArgs := r.config.ExtraGinkgoArgs
-
-	if r.config.Parallel > 1 {
-		extraGinkgoArgs = append(extraGinkgoArgs, fmt.Sprintf("--procs=%d", r.config.Parallel))
-	}
-
-	if verboseGinkgo {
-		extraGinkgoArgs = append(extraGinkgoArgs, "-v")
-	}
-
 	containerEnv = append(containerEnv, corev1.EnvVar{
 		Name:  "E2E_EXTRA_GINKGO_ARGS",
-		Value: strings.Join(extraGinkgoArgs, " "),
+		Value: strings.Join(phase.ginkgoArgs(r.config.ExtraGinkgoArgs, verboseGinkgo), " "),
 	})
 
 	if r.config.DryRun {
@@ -144,14 +316,14 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 
 	conformancePod := corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "e2e-conformance-test",
-			Namespace: conformanceNS.Name,
+			Name:      shard.PodName,
+			Namespace: r.config.Namespace,
 		},
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{
 				{
 					Name:            ConformanceContainer,
-					Image:           r.config.ConformanceImage,
+					Image:           r.config.PinnedConformanceImage(),
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					Env:             containerEnv,
 					VolumeMounts: []corev1.VolumeMount{
@@ -176,7 +348,7 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 				},
 				{
 					Name:    OutputContainer,
-					Image:   r.config.BusyboxImage,
+					Image:   r.config.PinnedBusyboxImage(),
 					Command: []string{"/bin/sh", "-c", "sleep infinity"},
 					VolumeMounts: []corev1.VolumeMount{
 						{
@@ -220,90 +392,14 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 		},
 	}
 
-	ns, err := r.clientset.CoreV1().Namespaces().Create(ctx, &conformanceNS, metav1.CreateOptions{})
-	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			if skipPreflight != "" {
-				log.Printf("Using existing namespace: %s", r.config.Namespace)
-			} else {
-				//nolint:stylecheck // error message references a Kubernetes resource type.
-				return fmt.Errorf("namespace %s already exists, please run with --cleanup first", conformanceNS.Name)
-			}
-		} else {
-			return fmt.Errorf("failed to create namespace: %w", err)
-		}
-	} else {
-		log.Printf("Created namespace %s.", ns.Name)
-	}
-
-	sa, err := r.clientset.CoreV1().ServiceAccounts(r.config.Namespace).Create(ctx, &conformanceSA, metav1.CreateOptions{})
-	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			if skipPreflight != "" {
-				log.Printf("using existing ServiceAccount: %s/%s", r.config.Namespace, ServiceAccountName)
-			} else {
-				return fmt.Errorf("serviceAccount %s already exists, please run --cleanup first", conformanceSA.Name)
-			}
-		} else {
-			return fmt.Errorf("failed to create ServiceAccount: %w", err)
-		}
-	} else {
-		log.Printf("Created ServiceAccount %s.", sa.Name)
-	}
-
-	clusterRole, err := r.clientset.RbacV1().ClusterRoles().Create(ctx, &conformanceClusterRole, metav1.CreateOptions{})
-	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			if skipPreflight != "" {
-				log.Printf("using existing ClusterRole: %s/%s", r.config.Namespace, r.namespacedName(ClusterRoleName))
-			} else {
-				return fmt.Errorf("clusterRole %s already exists, please run --cleanup first", conformanceClusterRole.Name)
-			}
-		} else {
-			return fmt.Errorf("failed to create ClusterRole: %w", err)
-		}
-	} else {
-		log.Printf("Created ClusterRole %s.", clusterRole.Name)
-	}
-
-	clusterRoleBinding, err := r.clientset.RbacV1().ClusterRoleBindings().Create(ctx, &conformanceClusterRoleBinding, metav1.CreateOptions{})
-	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			if skipPreflight != "" {
-				log.Printf("using existing ClusterRoleBinding: %s/%s", r.config.Namespace, r.namespacedName(ClusterRoleBindingName))
-			} else {
-				return fmt.Errorf("clusterRoleBinding %s already exists, please run --cleanup first", conformanceClusterRoleBinding.Name)
-			}
-		} else {
-			return fmt.Errorf("failed to create ClusterRoleBinding: %w", err)
-		}
-	} else {
-		log.Printf("Created ClusterRoleBinding %s.", clusterRoleBinding.Name)
-	}
-
-	if filename := r.config.TestRepoList; filename != "" {
-		repoListData, err := os.ReadFile(filename)
-		if err != nil {
-			return fmt.Errorf("failed to read repo list: %w", err)
-		}
-
-		configMap := &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "repo-list-config",
-				Namespace: r.config.Namespace,
-			},
-			Data: map[string]string{
-				"repo-list.yaml": string(repoListData),
-			},
-		}
-
+	if r.config.TestRepoList != "" {
 		conformancePod.Spec.Volumes = append(conformancePod.Spec.Volumes,
 			corev1.Volume{
 				Name: "repo-list-volume",
 				VolumeSource: corev1.VolumeSource{
 					ConfigMap: &corev1.ConfigMapVolumeSource{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: "repo-list-config",
+							Name: RepoListConfigMapName,
 						},
 					},
 				},
@@ -320,16 +416,6 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 			Name:  "KUBE_TEST_REPO_LIST",
 			Value: "/tmp/repo-list/repo-list.yaml",
 		})
-
-		cm, err := r.clientset.CoreV1().ConfigMaps(ns.Name).Create(ctx, configMap, metav1.CreateOptions{})
-		if err != nil {
-			if errors.IsAlreadyExists(err) {
-				err = fmt.Errorf("configMap %s already exists, please run --cleanup first", configMap.Name)
-			}
-
-			return err
-		}
-		log.Printf("Created ConfigMap %s.", cm.Name)
 	}
 
 	if r.config.TestRepo != "" {
@@ -339,20 +425,5 @@ func (r *TestRunner) Deploy(ctx context.Context, focus, skipPreflight string, ve
 		})
 	}
 
-	pod, err := common.CreatePod(ctx, r.clientset, &conformancePod, timeout)
-	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			if skipPreflight != "" {
-				log.Printf("using existing Pod: %s/%s", r.config.Namespace, "e2e-conformance-test")
-			} else {
-				return fmt.Errorf("pod %s already exists, please run --cleanup first", conformancePod.Name)
-			}
-		} else {
-			return fmt.Errorf("failed to create Pod: %w", err)
-		}
-	} else {
-		log.Printf("Created ConformancePod %s.", pod.Name)
-	}
-
-	return nil
+	return &conformancePod
 }
@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Outcomes of a health check. Only failures abort a run.
+const (
+	HealthPass = "pass"
+	HealthWarn = "warn"
+	HealthFail = "fail"
+)
+
+// minNodes is the number of schedulable nodes the specs testing communication
+// between nodes need.
+const minNodes = 2
+
+// controlPlaneTaints are the taints of control plane nodes, which the tests expect.
+var controlPlaneTaints = []string{"node-role.kubernetes.io/control-plane", "node-role.kubernetes.io/master"}
+
+// HealthCheck is the outcome of a single cluster health check.
+type HealthCheck struct {
+	Name    string
+	Status  string
+	Message string
+}
+
+// CheckHealth checks that the cluster is able to run the tests: all nodes must be
+// ready, enough nodes must be schedulable for test pods, the API server must be
+// ready and DNS must be available.
+func (r *TestRunner) CheckHealth(ctx context.Context) ([]HealthCheck, error) {
+	nodes, err := r.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	allowedNotReady, err := r.config.AllowedNotReadyNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	checks := checkNodes(nodes.Items, allowedNotReady)
+
+	for _, endpoint := range []string{"/livez", "/readyz"} {
+		checks = append(checks, r.checkEndpoint(ctx, endpoint))
+	}
+
+	checks = append(checks, r.checkComponentStatuses(ctx))
+
+	deployments, err := r.clientset.AppsV1().Deployments(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{
+		LabelSelector: "k8s-app=kube-dns",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DNS deployments: %w", err)
+	}
+
+	checks = append(checks, checkDNS(deployments.Items))
+
+	return checks, nil
+}
+
+// checkNodes checks the readiness, schedulability and taints of the nodes. Up to
+// allowedNotReady nodes may be not ready, as the e2e suite tolerates them as well.
+func checkNodes(nodes []corev1.Node, allowedNotReady int) []HealthCheck {
+	notReady, cordoned, tainted := []string{}, []string{}, []string{}
+	schedulable := 0
+
+	for _, node := range nodes {
+		taints := blockingTaints(node)
+
+		switch {
+		case !nodeReady(node):
+			notReady = append(notReady, node.Name)
+		case node.Spec.Unschedulable:
+			cordoned = append(cordoned, node.Name)
+		case len(taints) > 0 && !isControlPlane(node):
+			tainted = append(tainted, fmt.Sprintf("%s (%s)", node.Name, strings.Join(taints, ", ")))
+		case canRunTestPods(node):
+			schedulable++
+		}
+	}
+
+	checks := []HealthCheck{
+		{Name: "node readiness", Status: HealthPass, Message: fmt.Sprintf("all %d nodes are ready", len(nodes))},
+		{Name: "node schedulability", Status: HealthPass, Message: "no nodes are cordoned"},
+		{Name: "node taints", Status: HealthPass, Message: "no worker nodes are tainted"},
+		{Name: "node count", Status: HealthPass, Message: fmt.Sprintf("%d node(s) can run test pods", schedulable)},
+	}
+
+	switch {
+	case len(notReady) > allowedNotReady:
+		checks[0] = HealthCheck{Name: "node readiness", Status: HealthFail, Message: "not ready: " + strings.Join(notReady, ", ")}
+	case len(notReady) > 0:
+		checks[0] = HealthCheck{Name: "node readiness", Status: HealthWarn, Message: fmt.Sprintf("not ready: %s (--allowed-not-ready-nodes=%d)", strings.Join(notReady, ", "), allowedNotReady)}
+	}
+
+	if len(cordoned) > 0 {
+		checks[1] = HealthCheck{Name: "node schedulability", Status: HealthWarn, Message: "cordoned: " + strings.Join(cordoned, ", ")}
+	}
+
+	if len(tainted) > 0 {
+		checks[2] = HealthCheck{Name: "node taints", Status: HealthWarn, Message: "test pods cannot run on " + strings.Join(tainted, "; ")}
+	}
+
+	switch {
+	case schedulable == 0:
+		checks[3] = HealthCheck{Name: "node count", Status: HealthFail, Message: "no nodes can run test pods"}
+	case schedulable < minNodes:
+		checks[3] = HealthCheck{Name: "node count", Status: HealthWarn, Message: fmt.Sprintf("%d node(s) can run test pods, specs spanning multiple nodes need %d", schedulable, minNodes)}
+	}
+
+	return checks
+}
+
+// canRunTestPods returns true if test pods, which tolerate no taints, can be
+// scheduled on the node.
+func canRunTestPods(node corev1.Node) bool {
+	return nodeReady(node) && !node.Spec.Unschedulable && len(blockingTaints(node)) == 0
+}
+
+// nodeReady returns true if the Ready condition of the node is true.
+func nodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// blockingTaints returns the keys of the taints preventing test pods from being scheduled.
+func blockingTaints(node corev1.Node) []string {
+	keys := []string{}
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectNoSchedule || taint.Effect == corev1.TaintEffectNoExecute {
+			keys = append(keys, taint.Key)
+		}
+	}
+
+	return keys
+}
+
+// isControlPlane returns true if the node is tainted as a control plane node.
+func isControlPlane(node corev1.Node) bool {
+	return slices.ContainsFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+		return slices.Contains(controlPlaneTaints, taint.Key)
+	})
+}
+
+// checkEndpoint checks a health endpoint of the API server.
+func (r *TestRunner) checkEndpoint(ctx context.Context, endpoint string) HealthCheck {
+	check := HealthCheck{Name: "apiserver " + endpoint, Status: HealthPass, Message: "ok"}
+
+	if _, err := r.clientset.Discovery().RESTClient().Get().AbsPath(endpoint).DoRaw(ctx); err != nil {
+		check.Status, check.Message = HealthFail, err.Error()
+	}
+
+	return check
+}
+
+// checkComponentStatuses checks the health of the scheduler, controller manager and
+// etcd. The API is deprecated and not served by every cluster, so problems only
+// result in a warning.
+func (r *TestRunner) checkComponentStatuses(ctx context.Context) HealthCheck {
+	check := HealthCheck{Name: "control plane components", Status: HealthPass}
+
+	//nolint:staticcheck // there is no replacement for checking the health of all components
+	statuses, err := r.clientset.CoreV1().ComponentStatuses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		check.Status, check.Message = HealthWarn, fmt.Sprintf("cannot check: %v", err)
+		return check
+	}
+
+	healthy, unhealthy := []string{}, []string{}
+	for _, status := range statuses.Items {
+		if slices.ContainsFunc(status.Conditions, func(c corev1.ComponentCondition) bool {
+			return c.Type == corev1.ComponentHealthy && c.Status == corev1.ConditionTrue
+		}) {
+			healthy = append(healthy, status.Name)
+		} else {
+			unhealthy = append(unhealthy, status.Name)
+		}
+	}
+
+	check.Message = "healthy: " + strings.Join(healthy, ", ")
+	if len(unhealthy) > 0 {
+		check.Status, check.Message = HealthWarn, "unhealthy: "+strings.Join(unhealthy, ", ")
+	}
+
+	return check
+}
+
+// checkDNS checks that all replicas of the cluster DNS are ready.
+func checkDNS(deployments []appsv1.Deployment) HealthCheck {
+	check := HealthCheck{Name: "cluster DNS", Status: HealthPass}
+
+	if len(deployments) == 0 {
+		check.Status, check.Message = HealthWarn, "no deployment labeled k8s-app=kube-dns found in kube-system"
+		return check
+	}
+
+	messages := []string{}
+	for _, deployment := range deployments {
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+
+		ready := deployment.Status.ReadyReplicas
+		messages = append(messages, fmt.Sprintf("%s has %d/%d replicas ready", deployment.Name, ready, desired))
+
+		switch {
+		case ready == 0:
+			check.Status = HealthFail
+		case ready < desired && check.Status == HealthPass:
+			check.Status = HealthWarn
+		}
+	}
+
+	check.Message = strings.Join(messages, ", ")
+
+	return check
+}
+
+// HealthFailed returns true if any of the checks failed.
+func HealthFailed(checks []HealthCheck) bool {
+	return slices.ContainsFunc(checks, func(c HealthCheck) bool { return c.Status == HealthFail })
+}
+
+// PrintHealthChecks writes the outcome of the health checks as a table.
+func PrintHealthChecks(w io.Writer, checks []HealthCheck) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tSTATUS\tMESSAGE")
+	for _, c := range checks {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", c.Name, strings.ToUpper(c.Status), c.Message)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func testNode(name string, ready bool, taints ...string) corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+
+	node := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+		},
+	}
+
+	for _, key := range taints {
+		node.Spec.Taints = append(node.Spec.Taints, corev1.Taint{Key: key, Effect: corev1.TaintEffectNoSchedule})
+	}
+
+	return node
+}
+
+func TestCheckNodes(t *testing.T) {
+	cordoned := testNode("worker-3", true)
+	cordoned.Spec.Unschedulable = true
+
+	checks := checkNodes([]corev1.Node{
+		testNode("control-plane", true, "node-role.kubernetes.io/control-plane"),
+		testNode("worker-1", true),
+		testNode("worker-2", true, "gpu"),
+		cordoned,
+	}, 0)
+
+	assert.Equal(t, []HealthCheck{
+		{Name: "node readiness", Status: HealthPass, Message: "all 4 nodes are ready"},
+		{Name: "node schedulability", Status: HealthWarn, Message: "cordoned: worker-3"},
+		{Name: "node taints", Status: HealthWarn, Message: "test pods cannot run on worker-2 (gpu)"},
+		{Name: "node count", Status: HealthWarn, Message: "1 node(s) can run test pods, specs spanning multiple nodes need 2"},
+	}, checks)
+	assert.False(t, HealthFailed(checks))
+
+	nodes := []corev1.Node{testNode("worker-1", false), testNode("worker-2", true), testNode("worker-3", true)}
+
+	checks = checkNodes(nodes, 0)
+	assert.Equal(t, HealthCheck{Name: "node readiness", Status: HealthFail, Message: "not ready: worker-1"}, checks[0])
+	assert.Equal(t, HealthPass, checks[3].Status)
+	assert.True(t, HealthFailed(checks))
+
+	checks = checkNodes(nodes, 1)
+	assert.Equal(t, HealthCheck{Name: "node readiness", Status: HealthWarn, Message: "not ready: worker-1 (--allowed-not-ready-nodes=1)"}, checks[0])
+	assert.False(t, HealthFailed(checks))
+
+	nodes[1] = testNode("worker-2", false)
+	assert.Equal(t, HealthFail, checkNodes(nodes, 1)[0].Status)
+}
+
+func TestCheckDNS(t *testing.T) {
+	coredns := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "coredns"},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+	}
+
+	assert.Equal(t, HealthCheck{Name: "cluster DNS", Status: HealthPass, Message: "coredns has 2/2 replicas ready"}, checkDNS([]appsv1.Deployment{coredns}))
+
+	coredns.Status.ReadyReplicas = 1
+	assert.Equal(t, HealthWarn, checkDNS([]appsv1.Deployment{coredns}).Status)
+
+	coredns.Status.ReadyReplicas = 0
+	assert.Equal(t, HealthFail, checkDNS([]appsv1.Deployment{coredns}).Status)
+
+	assert.Equal(t, HealthWarn, checkDNS(nil).Status)
+}
+
+func TestPrintHealthChecks(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	require.NoError(t, PrintHealthChecks(buf, []HealthCheck{
+		{Name: "node readiness", Status: HealthFail, Message: "not ready: worker-1"},
+		{Name: "cluster DNS", Status: HealthPass, Message: "coredns has 2/2 replicas ready"},
+	}))
+
+	assert.Equal(t, ""+
+		"CHECK           STATUS  MESSAGE\n"+
+		"node readiness  FAIL    not ready: worker-1\n"+
+		"cluster DNS     PASS    coredns has 2/2 replicas ready\n", buf.String())
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImages(t *testing.T) {
+	logs := "registry.k8s.io/pause:3.10\n\nregistry.k8s.io/e2e-test-images/agnhost:2.53\nregistry.k8s.io/pause:3.10\n"
+
+	assert.Equal(t, []string{
+		"registry.k8s.io/e2e-test-images/agnhost:2.53",
+		"registry.k8s.io/pause:3.10",
+	}, parseImages([]byte(logs)))
+}
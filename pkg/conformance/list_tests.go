@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/common"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// specReportFile is where the e2e binary writes its Ginkgo JSON report while listing tests.
+const specReportFile = "/tmp/results/specs.json"
+
+// specTag matches the tags embedded in a spec name, e.g. [sig-node] or [Conformance].
+var specTag = regexp.MustCompile(`\[([^\[\]]+)\]`)
+
+// Spec is a single test of the e2e suite.
+type Spec struct {
+	// Name is the full text of the spec, as used for focus and skip expressions.
+	Name string `json:"name" yaml:"name"`
+	// Tags are the bracketed tags in the name of the spec, e.g. sig-node or Serial.
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Labels are the Ginkgo labels of the spec and its containers.
+	Labels []string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+// PrintSpecs writes the specs in the given output format.
+func PrintSpecs(w io.Writer, specs []Spec, output string) error {
+	switch output {
+	case types.OutputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(specs)
+
+	case types.OutputYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(specs); err != nil {
+			return err
+		}
+
+		return encoder.Close()
+
+	default:
+		for _, spec := range specs {
+			if _, err := fmt.Fprintln(w, spec.Name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// ListTests runs the e2e binary of the conformance image in Ginkgo's dry-run mode
+// and returns the specs the given phase would execute, sorted by name.
+func (r *TestRunner) ListTests(ctx context.Context, phase Phase, timeout time.Duration) ([]Spec, error) {
+	args := []string{
+		"/usr/local/bin/e2e.test",
+		"--provider=skeleton",
+		"--ginkgo.dry-run",
+		"--ginkgo.no-color",
+		"--ginkgo.json-report=" + specReportFile,
+	}
+
+	if phase.Focus != "" {
+		args = append(args, "--ginkgo.focus="+phase.Focus)
+	}
+
+	if phase.Skip != "" {
+		args = append(args, "--ginkgo.skip="+phase.Skip)
+	}
+
+	for _, arg := range r.config.ExtraGinkgoArgs {
+		if labelFilter, ok := strings.CutPrefix(arg, "--label-filter="); ok {
+			args = append(args, "--ginkgo.label-filter="+labelFilter)
+		}
+	}
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "output-volume",
+			MountPath: "/tmp/results",
+		},
+	}
+
+	// The e2e binary writes the report into a shared volume and the busybox
+	// container keeps the pod running until the report is copied out of it.
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "list-tests-",
+			Namespace:    metav1.NamespaceDefault,
+			Annotations: map[string]string{
+				"list-tests": "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			InitContainers: []corev1.Container{
+				{
+					Name:         ConformanceContainer,
+					Image:        r.config.PinnedConformanceImage(),
+					Command:      args,
+					VolumeMounts: volumeMounts,
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:         OutputContainer,
+					Image:        r.config.PinnedBusyboxImage(),
+					Command:      []string{"/bin/sh", "-c", "sleep infinity"},
+					VolumeMounts: volumeMounts,
+				},
+			},
+			TerminationGracePeriodSeconds: ptr.To(int64(0)),
+			Volumes: []corev1.Volume{
+				{
+					Name: "output-volume",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+		},
+	}
+
+	created, err := common.CreatePod(ctx, r.clientset, pod, timeout)
+	defer func() {
+		if created != nil {
+			err := r.clientset.CoreV1().Pods(created.Namespace).Delete(context.WithoutCancel(ctx), created.Name, metav1.DeleteOptions{})
+			if err != nil {
+				log.Errorf("Failed to delete Pod: %v.", err)
+			}
+		}
+	}()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Pod: %w", err)
+	}
+
+	// Pod logs may be truncated by the kubelet, so the report is copied out instead.
+	var report bytes.Buffer
+	if err := common.ExecStream(ctx, r.restConfig, r.clientset, created, OutputContainer, []string{"cat", specReportFile}, &report); err != nil {
+		return nil, fmt.Errorf("failed to copy the Ginkgo report: %w", err)
+	}
+
+	return parseSpecReport(report.Bytes())
+}
+
+// ginkgoReport is the subset of Ginkgo's JSON report needed to list specs.
+type ginkgoReport struct {
+	SpecReports []struct {
+		ContainerHierarchyTexts  []string
+		ContainerHierarchyLabels [][]string
+		LeafNodeType             string
+		LeafNodeText             string
+		LeafNodeLabels           []string
+		State                    string
+	}
+}
+
+// parseSpecReport extracts all specs that were selected to run from a Ginkgo JSON report.
+func parseSpecReport(data []byte) ([]Spec, error) {
+	reports := []ginkgoReport{}
+	if err := json.Unmarshal(data, &reports); err != nil {
+		return nil, fmt.Errorf("invalid Ginkgo report: %w", err)
+	}
+
+	specs := []Spec{}
+
+	for _, report := range reports {
+		for _, spec := range report.SpecReports {
+			// skip suite-level nodes and specs that were filtered out
+			if spec.LeafNodeType != "It" || spec.State != "passed" {
+				continue
+			}
+
+			texts := []string{}
+			labels := []string{}
+
+			for i, text := range spec.ContainerHierarchyTexts {
+				if text != "" {
+					texts = append(texts, text)
+				}
+
+				if i < len(spec.ContainerHierarchyLabels) {
+					labels = append(labels, spec.ContainerHierarchyLabels[i]...)
+				}
+			}
+
+			if spec.LeafNodeText != "" {
+				texts = append(texts, spec.LeafNodeText)
+			}
+
+			labels = append(labels, spec.LeafNodeLabels...)
+			slices.Sort(labels)
+
+			name := strings.Join(texts, " ")
+
+			specs = append(specs, Spec{
+				Name:   name,
+				Tags:   specTags(name),
+				Labels: slices.Compact(labels),
+			})
+		}
+	}
+
+	slices.SortFunc(specs, func(a, b Spec) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return specs, nil
+}
+
+// specTags returns the tags embedded in a spec name, in the order they appear.
+func specTags(name string) []string {
+	tags := []string{}
+	for _, match := range specTag.FindAllStringSubmatch(name, -1) {
+		tags = append(tags, match[1])
+	}
+
+	return tags
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"bytes"
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const specReport = `[
+  {
+    "SuitePath": "/usr/local/bin",
+    "SuiteDescription": "Kubernetes e2e suite",
+    "SpecReports": [
+      {
+        "ContainerHierarchyTexts": null,
+        "ContainerHierarchyLabels": null,
+        "LeafNodeType": "SynchronizedBeforeSuite",
+        "LeafNodeText": "",
+        "State": "passed"
+      },
+      {
+        "ContainerHierarchyTexts": ["[sig-node] Pods", ""],
+        "ContainerHierarchyLabels": [["sig-node"], []],
+        "LeafNodeType": "It",
+        "LeafNodeText": "should be evicted [Serial] [Conformance]",
+        "LeafNodeLabels": ["Serial", "Conformance"],
+        "State": "passed"
+      },
+      {
+        "ContainerHierarchyTexts": ["[sig-apps] Deployment"],
+        "ContainerHierarchyLabels": [["sig-apps"]],
+        "LeafNodeType": "It",
+        "LeafNodeText": "should work [Conformance]",
+        "LeafNodeLabels": ["Conformance"],
+        "State": "passed"
+      },
+      {
+        "ContainerHierarchyTexts": ["[sig-storage] Volumes"],
+        "ContainerHierarchyLabels": [["sig-storage"]],
+        "LeafNodeType": "It",
+        "LeafNodeText": "should mount",
+        "State": "skipped"
+      }
+    ]
+  }
+]`
+
+func TestParseSpecReport(t *testing.T) {
+	specs, err := parseSpecReport([]byte(specReport))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Spec{
+		{
+			Name:   "[sig-apps] Deployment should work [Conformance]",
+			Tags:   []string{"sig-apps", "Conformance"},
+			Labels: []string{"Conformance", "sig-apps"},
+		},
+		{
+			Name:   "[sig-node] Pods should be evicted [Serial] [Conformance]",
+			Tags:   []string{"sig-node", "Serial", "Conformance"},
+			Labels: []string{"Conformance", "Serial", "sig-node"},
+		},
+	}, specs)
+
+	_, err = parseSpecReport([]byte("Error: unknown flag"))
+	assert.Error(t, err)
+}
+
+func TestPrintSpecs(t *testing.T) {
+	specs := []Spec{
+		{
+			Name:   "[sig-node] Pods should be evicted [Serial] [Conformance]",
+			Tags:   []string{"sig-node", "Serial", "Conformance"},
+			Labels: []string{"Conformance", "Serial"},
+		},
+		{
+			Name: "[sig-storage] Volumes should mount",
+			Tags: []string{"sig-storage"},
+		},
+	}
+
+	testCases := []struct {
+		output   string
+		expected string
+	}{
+		{
+			output: types.OutputText,
+			expected: `[sig-node] Pods should be evicted [Serial] [Conformance]
+[sig-storage] Volumes should mount
+`,
+		},
+		{
+			output: types.OutputJSON,
+			expected: `[
+  {
+    "name": "[sig-node] Pods should be evicted [Serial] [Conformance]",
+    "tags": [
+      "sig-node",
+      "Serial",
+      "Conformance"
+    ],
+    "labels": [
+      "Conformance",
+      "Serial"
+    ]
+  },
+  {
+    "name": "[sig-storage] Volumes should mount",
+    "tags": [
+      "sig-storage"
+    ]
+  }
+]
+`,
+		},
+		{
+			output: types.OutputYAML,
+			expected: `- name: '[sig-node] Pods should be evicted [Serial] [Conformance]'
+  tags:
+    - sig-node
+    - Serial
+    - Conformance
+  labels:
+    - Conformance
+    - Serial
+- name: '[sig-storage] Volumes should mount'
+  tags:
+    - sig-storage
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.output, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, PrintSpecs(&buf, specs, tc.output))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// conformanceURLs are the non-resource URLs the conformance ClusterRole grants access to.
+var conformanceURLs = []string{"/metrics", "/logs", "/logs/*"}
+
+// permission is an action the current identity must be allowed to perform.
+type permission struct {
+	Verb        string
+	Group       string
+	Resource    string
+	Subresource string
+	Name        string
+	Namespace   string
+	Path        string
+}
+
+// String returns the permission like "create pods/exec in namespace conformance".
+func (p permission) String() string {
+	if p.Path != "" {
+		return fmt.Sprintf("%s %s", p.Verb, p.Path)
+	}
+
+	if p.Verb == "*" && p.Resource == "*" {
+		return "all verbs on all resources"
+	}
+
+	resource := p.Resource
+	if p.Group != "" {
+		resource += "." + p.Group
+	}
+
+	if p.Subresource != "" {
+		resource += "/" + p.Subresource
+	}
+
+	if p.Name != "" {
+		resource += " " + p.Name
+	}
+
+	if p.Namespace != "" {
+		return fmt.Sprintf("%s %s in namespace %s", p.Verb, resource, p.Namespace)
+	}
+
+	return fmt.Sprintf("%s %s", p.Verb, resource)
+}
+
+// spec returns the access review checking the permission.
+func (p permission) spec() authorizationv1.SelfSubjectAccessReviewSpec {
+	if p.Path != "" {
+		return authorizationv1.SelfSubjectAccessReviewSpec{
+			NonResourceAttributes: &authorizationv1.NonResourceAttributes{Verb: p.Verb, Path: p.Path},
+		}
+	}
+
+	return authorizationv1.SelfSubjectAccessReviewSpec{
+		ResourceAttributes: &authorizationv1.ResourceAttributes{
+			Namespace:   p.Namespace,
+			Verb:        p.Verb,
+			Group:       p.Group,
+			Resource:    p.Resource,
+			Subresource: p.Subresource,
+			Name:        p.Name,
+		},
+	}
+}
+
+// reviewFunc returns true if the current identity is allowed the access.
+type reviewFunc func(ctx context.Context, spec authorizationv1.SelfSubjectAccessReviewSpec) (bool, error)
+
+// CheckPermissions checks that the current identity may create and manage all
+// resources needed to run the tests, and returns an error listing all missing
+// permissions. If listInCluster is set, because the catalog of the conformance image
+// is not cached, its contents are listed by pods in the default namespace, which
+// must be allowed too.
+func (r *TestRunner) CheckPermissions(ctx context.Context, listInCluster bool) error {
+	missing, err := r.missingPermissions(ctx, listInCluster, r.reviewAccess)
+	if err != nil {
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+
+	return permissionsError("run the tests", missing)
+}
+
+// CheckListPermissions checks that the current identity may list the tests and
+// images of the conformance image by running pods in the default namespace.
+func (r *TestRunner) CheckListPermissions(ctx context.Context) error {
+	missing, err := deniedPermissions(ctx, listPermissions(), r.reviewAccess)
+	if err != nil {
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+
+	return permissionsError("list the tests and images", missing)
+}
+
+// permissionsError returns an error listing the missing permissions, if any.
+func permissionsError(purpose string, missing []string) error {
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("missing permissions to %s:\n  %s", purpose, strings.Join(missing, "\n  "))
+}
+
+// reviewAccess asks the API server whether the current identity is allowed the access.
+func (r *TestRunner) reviewAccess(ctx context.Context, spec authorizationv1.SelfSubjectAccessReviewSpec) (bool, error) {
+	review, err := r.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{Spec: spec}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
+
+// missingPermissions returns the required permissions that are not allowed.
+// Creating the conformance ClusterRole and binding it requires either all
+// permissions it grants, or to be allowed to escalate and bind it.
+func (r *TestRunner) missingPermissions(ctx context.Context, listInCluster bool, review reviewFunc) ([]string, error) {
+	missing, err := deniedPermissions(ctx, r.requiredPermissions(listInCluster), review)
+	if err != nil {
+		return nil, err
+	}
+
+	wildcard, err := deniedPermissions(ctx, r.clusterRolePermissions(), review)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wildcard) == 0 {
+		return missing, nil
+	}
+
+	clusterRole := r.namespacedName(ClusterRoleName)
+	escalation, err := deniedPermissions(ctx, []permission{
+		{Verb: "escalate", Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Name: clusterRole},
+		{Verb: "bind", Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Name: clusterRole},
+	}, review)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range escalation {
+		missing = append(missing, fmt.Sprintf("%s (or %s)", p, strings.Join(wildcard, ", ")))
+	}
+
+	return missing, nil
+}
+
+// deniedPermissions reviews the permissions and returns the ones not allowed.
+func deniedPermissions(ctx context.Context, permissions []permission, review reviewFunc) ([]string, error) {
+	denied := []string{}
+
+	for _, p := range permissions {
+		allowed, err := review(ctx, p.spec())
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			denied = append(denied, p.String())
+		}
+	}
+
+	return denied, nil
+}
+
+// requiredPermissions returns the permissions needed to deploy, run and follow the
+// tests. Sharding and pre-pulling always list the contents of the conformance
+// image in the cluster, other runs only if listInCluster is set.
+func (r *TestRunner) requiredPermissions(listInCluster bool) []permission {
+	ns := r.config.Namespace
+
+	permissions := []permission{
+		{Verb: "create", Resource: "namespaces"},
+		// the scratch namespaces of the admission checks
+		{Verb: "delete", Resource: "namespaces"},
+		{Verb: "create", Resource: "serviceaccounts", Namespace: ns},
+		{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+		{Verb: "create", Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+	}
+
+	for _, verb := range []string{"create", "get", "list", "watch", "delete", "deletecollection"} {
+		permissions = append(permissions, permission{Verb: verb, Resource: "pods", Namespace: ns})
+	}
+
+	permissions = append(permissions,
+		permission{Verb: "get", Resource: "pods", Subresource: "log", Namespace: ns},
+		permission{Verb: "create", Resource: "pods", Subresource: "exec", Namespace: ns},
+		// the network probe
+		permission{Verb: "create", Resource: "services", Namespace: ns},
+		permission{Verb: "delete", Resource: "services", Namespace: ns},
+	)
+
+	if r.config.TestRepoList != "" {
+		permissions = append(permissions, permission{Verb: "create", Resource: "configmaps", Namespace: ns})
+	}
+
+	if r.config.PrepullImages {
+		for _, verb := range []string{"create", "get", "delete"} {
+			permissions = append(permissions, permission{Verb: verb, Group: "apps", Resource: "daemonsets", Namespace: ns})
+		}
+	}
+
+	if listInCluster || r.config.Shards > 1 || r.config.PrepullImages {
+		permissions = append(permissions, listPermissions()...)
+	}
+
+	return permissions
+}
+
+// listPermissions returns the permissions needed to list the tests and images of
+// the conformance image with pods in the default namespace.
+func listPermissions() []permission {
+	permissions := []permission{}
+	for _, verb := range []string{"create", "get", "watch", "delete"} {
+		permissions = append(permissions, permission{Verb: verb, Resource: "pods", Namespace: metav1.NamespaceDefault})
+	}
+
+	return append(permissions,
+		permission{Verb: "get", Resource: "pods", Subresource: "log", Namespace: metav1.NamespaceDefault},
+		// the Ginkgo report is copied out of the pod listing the tests
+		permission{Verb: "create", Resource: "pods", Subresource: "exec", Namespace: metav1.NamespaceDefault},
+	)
+}
+
+// clusterRolePermissions returns the permissions granted by the conformance ClusterRole.
+func (r *TestRunner) clusterRolePermissions() []permission {
+	permissions := []permission{{Verb: "*", Group: "*", Resource: "*"}}
+	for _, path := range conformanceURLs {
+		permissions = append(permissions, permission{Verb: "get", Path: path})
+	}
+
+	return permissions
+}
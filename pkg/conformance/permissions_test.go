@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// denyReview returns a review allowing everything but the denied permissions.
+func denyReview(denied ...permission) reviewFunc {
+	return func(_ context.Context, spec authorizationv1.SelfSubjectAccessReviewSpec) (bool, error) {
+		for _, p := range denied {
+			if assert.ObjectsAreEqual(p.spec(), spec) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+}
+
+func TestMissingPermissions(t *testing.T) {
+	runner := NewTestRunner(types.Configuration{Namespace: "conformance"}, nil, nil)
+	wildcard := permission{Verb: "*", Group: "*", Resource: "*"}
+	escalate := permission{Verb: "escalate", Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Name: "conformance-serviceaccount:conformance"}
+	bind := permission{Verb: "bind", Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Name: "conformance-serviceaccount:conformance"}
+
+	testCases := []struct {
+		name     string
+		denied   []permission
+		expected []string
+	}{
+		{
+			name:     "cluster admin",
+			expected: []string{},
+		},
+		{
+			name: "missing resource permissions",
+			denied: []permission{
+				{Verb: "create", Resource: "namespaces"},
+				{Verb: "create", Resource: "pods", Subresource: "exec", Namespace: "conformance"},
+			},
+			expected: []string{"create namespaces", "create pods/exec in namespace conformance"},
+		},
+		{
+			name:     "listing in the cluster",
+			denied:   []permission{{Verb: "get", Resource: "pods", Subresource: "log", Namespace: "default"}},
+			expected: []string{"get pods/log in namespace default"},
+		},
+		{
+			name:     "allowed to escalate",
+			denied:   []permission{wildcard},
+			expected: []string{},
+		},
+		{
+			name:   "not allowed to escalate",
+			denied: []permission{wildcard, {Verb: "get", Path: "/metrics"}, bind},
+			expected: []string{
+				"bind clusterroles.rbac.authorization.k8s.io conformance-serviceaccount:conformance (or all verbs on all resources, get /metrics)",
+			},
+		},
+		{
+			name:   "neither allowed to escalate nor bind",
+			denied: []permission{wildcard, escalate, bind},
+			expected: []string{
+				"escalate clusterroles.rbac.authorization.k8s.io conformance-serviceaccount:conformance (or all verbs on all resources)",
+				"bind clusterroles.rbac.authorization.k8s.io conformance-serviceaccount:conformance (or all verbs on all resources)",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			missing, err := runner.missingPermissions(context.Background(), true, denyReview(tc.denied...))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, missing)
+		})
+	}
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	listPod := permission{Verb: "create", Resource: "pods", Namespace: "default"}
+
+	runner := NewTestRunner(types.Configuration{Namespace: "conformance"}, nil, nil)
+	assert.NotContains(t, runner.requiredPermissions(false), permission{Verb: "create", Group: "apps", Resource: "daemonsets", Namespace: "conformance"})
+	assert.NotContains(t, runner.requiredPermissions(false), permission{Verb: "create", Resource: "configmaps", Namespace: "conformance"})
+	assert.NotContains(t, runner.requiredPermissions(false), listPod)
+	assert.Contains(t, runner.requiredPermissions(true), listPod)
+
+	runner = NewTestRunner(types.Configuration{Namespace: "conformance", PrepullImages: true, TestRepoList: "repo-list.yaml"}, nil, nil)
+	assert.Contains(t, runner.requiredPermissions(false), permission{Verb: "create", Group: "apps", Resource: "daemonsets", Namespace: "conformance"})
+	assert.Contains(t, runner.requiredPermissions(false), permission{Verb: "create", Resource: "configmaps", Namespace: "conformance"})
+	// pre-pulling always lists the images in the cluster
+	assert.Contains(t, runner.requiredPermissions(false), listPod)
+
+	// sharding always lists the tests in the cluster
+	runner = NewTestRunner(types.Configuration{Namespace: "conformance", Shards: 2}, nil, nil)
+	assert.Contains(t, runner.requiredPermissions(false), listPod)
+}
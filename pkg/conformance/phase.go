@@ -0,0 +1,297 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// serialTag is the tag in the names of all specs that must not run in parallel.
+// Older conformance images do not attach a Serial label to these specs, so they
+// are selected by name.
+const serialTag = "[Serial]"
+
+// maxFocusLength is the maximum length of a focus expression. Linux limits every
+// argument and environment variable to 128 KiB (MAX_ARG_STRLEN), including the
+// name of the flag the focus is passed with.
+const maxFocusLength = 128*1024 - len("--ginkgo.focus=") - 1
+
+var (
+	// serialSpecs matches the names of all specs that must not run in parallel.
+	serialSpecs = regexp.QuoteMeta(serialTag)
+
+	// parallelSpecs matches the names of all other specs. Skipping serialSpecs in
+	// one phase and parallelSpecs in the other runs every spec exactly once,
+	// regardless of the focus.
+	parallelSpecs = notContaining(serialTag)
+)
+
+// Phase describes a single invocation of the e2e test suite. Phases are executed
+// one after another, but the shards of a phase run concurrently.
+type Phase struct {
+	// Name identifies the phase in logs and is used as directory name for its results.
+	Name string
+	// Focus is the regular expression selecting the specs to run.
+	Focus string
+	// Skip is the regular expression selecting the specs to skip.
+	Skip string
+	// Parallel is the number of Ginkgo processes running the specs in each shard.
+	Parallel int
+	// Shards are the conformance pods running this phase.
+	Shards []Shard
+}
+
+// Shard is a subset of the specs of a phase, executed by its own conformance pod.
+type Shard struct {
+	// PodName is the name of the conformance pod running this shard.
+	PodName string
+	// Focus is the regular expression selecting the specs of this shard.
+	Focus string
+}
+
+// PodNames returns the names of all conformance pods of the phase.
+func (p *Phase) PodNames() []string {
+	names := make([]string, 0, len(p.Shards))
+	for _, shard := range p.Shards {
+		names = append(names, shard.PodName)
+	}
+
+	return names
+}
+
+// Phases splits a test run into the phases that need to be executed. When more than
+// one Ginkgo process or shard is used, all [Serial] specs are skipped in the parallel
+// phase and run afterwards in a dedicated phase using a single process. If sharding
+// is enabled, the specs of the parallel phase are determined upfront and distributed
+// across the configured number of conformance pods. When resuming a run, the shards
+// of the conformance pods already deployed are reused instead.
+func (r *TestRunner) Phases(ctx context.Context, focus string, resume bool, timeout time.Duration) ([]Phase, error) {
+	if r.config.Parallel <= 1 && r.config.Shards <= 1 {
+		return []Phase{{
+			Name:     "default",
+			Focus:    focus,
+			Skip:     r.config.Skip,
+			Parallel: 1,
+			Shards:   []Shard{{PodName: PodName, Focus: focus}},
+		}}, nil
+	}
+
+	parallel := Phase{
+		Name:     "parallel",
+		Focus:    focus,
+		Skip:     joinRegex(r.config.Skip, serialSpecs),
+		Parallel: max(r.config.Parallel, 1),
+		Shards:   []Shard{{PodName: PodName, Focus: focus}},
+	}
+
+	serial := Phase{
+		Name:     "serial",
+		Focus:    focus,
+		Skip:     joinRegex(r.config.Skip, parallelSpecs),
+		Parallel: 1,
+		Shards:   []Shard{{PodName: PodName + "-serial", Focus: focus}},
+	}
+
+	if r.config.Shards > 1 && resume {
+		shards, err := r.deployedShards(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(shards) > 0 {
+			log.Printf("Continuing with %d deployed shards.", len(shards))
+			parallel.Shards = shards
+
+			return []Phase{parallel, serial}, nil
+		}
+	}
+
+	if r.config.Shards > 1 {
+		log.Printf("Determining tests to distribute across %d shards...", r.config.Shards)
+
+		specs, err := r.ListTests(ctx, parallel, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tests: %w", err)
+		}
+
+		if parallel.Shards, err = shardSpecs(specs, r.config.Shards); err != nil {
+			return nil, err
+		}
+
+		log.Printf("Distributed %d tests across %d shards.", len(specs), len(parallel.Shards))
+	}
+
+	return []Phase{parallel, serial}, nil
+}
+
+// deployedShards returns the shards of the conformance pods that are deployed
+// already, with the focus they were started with. Fewer shards than configured
+// may have been deployed if there were fewer specs than shards.
+func (r *TestRunner) deployedShards(ctx context.Context) ([]Shard, error) {
+	shards := []Shard{}
+
+	for i := range r.config.Shards {
+		pod, err := r.clientset.CoreV1().Pods(r.config.Namespace).Get(ctx, shardPodName(i), metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				break
+			}
+
+			return nil, fmt.Errorf("failed to get Pod: %w", err)
+		}
+
+		shards = append(shards, Shard{PodName: pod.Name, Focus: podFocus(pod)})
+	}
+
+	return shards, nil
+}
+
+// podFocus returns the focus the conformance container of the pod runs with.
+func podFocus(pod *corev1.Pod) string {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != ConformanceContainer {
+			continue
+		}
+
+		for _, env := range container.Env {
+			if env.Name == "E2E_FOCUS" {
+				return env.Value
+			}
+		}
+	}
+
+	return ""
+}
+
+// notContaining returns an expression matching all texts that do not contain the
+// tag. Go regular expressions have no negative lookahead, so the text is split at
+// every "[" and no part may continue with the rest of the tag. The tag must start
+// with "[" and contain no other "[".
+func notContaining(tag string) string {
+	rest := []rune(strings.TrimPrefix(tag, "["))
+
+	// part matches a text without "[" that does not start with rest[i:]
+	var part func(i int) string
+	part = func(i int) string {
+		char := regexp.QuoteMeta(string(rest[i]))
+		other := `[^\[` + char + `][^\[]*`
+
+		if i == len(rest)-1 {
+			return "(?:|" + other + ")"
+		}
+
+		return "(?:|" + other + "|" + char + part(i+1) + ")"
+	}
+
+	return `^[^\[]*(?:\[+` + part(0) + `)*$`
+}
+
+// shardSpecs distributes specs round-robin across at most n shards. Each shard
+// focuses exactly on the names of its specs, so the shards are disjoint.
+func shardSpecs(specs []Spec, n int) ([]Shard, error) {
+	if len(specs) == 0 {
+		// nothing to distribute; a single shard will report that no tests ran
+		return []Shard{{PodName: shardPodName(0), Focus: "^$"}}, nil
+	}
+
+	groups := make([][]string, min(n, len(specs)))
+	for i, spec := range specs {
+		groups[i%len(groups)] = append(groups[i%len(groups)], spec.Name)
+	}
+
+	shards := make([]Shard, 0, len(groups))
+	for i, names := range groups {
+		focus, err := ExactFocus(names)
+		if err != nil {
+			return nil, fmt.Errorf("shard %d: %w, use more shards or a narrower focus", i+1, err)
+		}
+
+		shards = append(shards, Shard{
+			PodName: shardPodName(i),
+			Focus:   focus,
+		})
+	}
+
+	return shards, nil
+}
+
+// ExactFocus returns a focus expression that selects exactly the specs with the
+// given names. Ginkgo matches the focus against the suite description followed by
+// the spec name, so the names are anchored at the preceding space. The focus is
+// passed to the e2e binary as a single argument, so an error is returned if it
+// exceeds the length the kernel allows.
+func ExactFocus(names []string) (string, error) {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+
+	focus := "(?:^| )(" + strings.Join(quoted, "|") + ")$"
+	if len(focus) > maxFocusLength {
+		return "", fmt.Errorf("the focus on %d specs is %d bytes long, more than the %d bytes an argument may have", len(names), len(focus), maxFocusLength)
+	}
+
+	return focus, nil
+}
+
+// shardPodName returns the conformance pod name for the i-th (zero-based) shard.
+func shardPodName(i int) string {
+	return fmt.Sprintf("%s-shard-%d", PodName, i+1)
+}
+
+// ginkgoArgs returns the Ginkgo arguments for running this phase, based on
+// the user-supplied extra arguments.
+func (p *Phase) ginkgoArgs(extraGinkgoArgs []string, verboseGinkgo bool) []string {
+	args := slices.Clone(extraGinkgoArgs)
+
+	if p.Parallel > 1 {
+		args = append(args, fmt.Sprintf("--procs=%d", p.Parallel))
+	}
+
+	if verboseGinkgo {
+		args = append(args, "-v")
+	}
+
+	return args
+}
+
+// joinRegex combines regular expressions so that the result matches if any of them matches.
+func joinRegex(exprs ...string) string {
+	nonEmpty := []string{}
+	for _, expr := range exprs {
+		if expr != "" {
+			nonEmpty = append(nonEmpty, expr)
+		}
+	}
+
+	if len(nonEmpty) == 1 {
+		return nonEmpty[0]
+	}
+
+	return strings.Join(nonEmpty, "|")
+}
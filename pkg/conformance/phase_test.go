@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhases(t *testing.T) {
+	t.Run("single process", func(t *testing.T) {
+		runner := NewTestRunner(types.Configuration{Parallel: 1, Skip: "sig-storage"}, nil, nil)
+
+		phases, err := runner.Phases(context.Background(), `\[Conformance\]`, false, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, phases, 1)
+		assert.Equal(t, []string{PodName}, phases[0].PodNames())
+		assert.Equal(t, `\[Conformance\]`, phases[0].Shards[0].Focus)
+		assert.Equal(t, "sig-storage", phases[0].Skip)
+	})
+
+	t.Run("multiple processes", func(t *testing.T) {
+		runner := NewTestRunner(types.Configuration{Parallel: 4, Skip: "sig-storage"}, nil, nil)
+
+		phases, err := runner.Phases(context.Background(), `\[Conformance\]`, false, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, phases, 2)
+
+		assert.Equal(t, []string{PodName}, phases[0].PodNames())
+		assert.Equal(t, `sig-storage|\[Serial\]`, phases[0].Skip)
+		assert.Equal(t, 4, phases[0].Parallel)
+
+		assert.Equal(t, []string{PodName + "-serial"}, phases[1].PodNames())
+		assert.Equal(t, `\[Conformance\]`, phases[1].Shards[0].Focus)
+		assert.Equal(t, "sig-storage|"+parallelSpecs, phases[1].Skip)
+		assert.Equal(t, 1, phases[1].Parallel)
+	})
+}
+
+func TestPodFocus(t *testing.T) {
+	runner := NewTestRunner(types.Configuration{Namespace: "conformance", Shards: 2}, nil, nil)
+	focus, err := ExactFocus([]string{"[sig-node] Pods should be submitted and removed [NodeConformance] [Conformance]"})
+	require.NoError(t, err)
+
+	shard := Shard{PodName: shardPodName(1), Focus: focus}
+
+	pod := runner.conformancePod(Phase{Name: "parallel", Skip: serialSpecs}, shard, false)
+	assert.Equal(t, shard.Focus, podFocus(pod))
+
+	pod.Spec.Containers = nil
+	assert.Empty(t, podFocus(pod))
+}
+
+func TestPhasesRunEverySpecOnce(t *testing.T) {
+	specs := []string{
+		"[sig-apps] Daemon set [Serial] should rollback without unnecessary restarts [Conformance]",
+		"[sig-scheduling] SchedulerPredicates [Serial] validates resource limits of pods that are allowed to run [Conformance]",
+		"[sig-node] Pods should be submitted and removed [NodeConformance] [Conformance]",
+		"[sig-storage] EmptyDir volumes should support (root,0644,tmpfs) [LinuxOnly] [NodeConformance] [Conformance]",
+		"[sig-network] Services [Serial-ish] should not be serial [Conformance]",
+		"[sig-node] [[Serial] nested brackets [Conformance]",
+		"[sig-node] Serial] without bracket [Conformance]",
+		"[Serial]",
+	}
+
+	exact, err := ExactFocus(specs[:3])
+	require.NoError(t, err)
+
+	focuses := []string{
+		`\[Conformance\]`,
+		`\[Serial\]`,
+		`Serial`,
+		exact,
+	}
+
+	for _, focus := range focuses {
+		runner := NewTestRunner(types.Configuration{Parallel: 4, Skip: "sig-storage"}, nil, nil)
+
+		phases, err := runner.Phases(context.Background(), focus, false, time.Minute)
+		require.NoError(t, err)
+
+		for _, spec := range specs {
+			selected := regexp.MustCompile(focus).MatchString(spec) && !strings.Contains(spec, "sig-storage")
+
+			runs := []string{}
+			for _, phase := range phases {
+				if regexp.MustCompile(phase.Focus).MatchString(spec) && !regexp.MustCompile(phase.Skip).MatchString(spec) {
+					runs = append(runs, phase.Name)
+				}
+			}
+
+			switch {
+			case !selected:
+				assert.Empty(t, runs, "focus %q, spec %q", focus, spec)
+			case strings.Contains(spec, "[Serial]"):
+				assert.Equal(t, []string{"serial"}, runs, "focus %q, spec %q", focus, spec)
+			default:
+				assert.Equal(t, []string{"parallel"}, runs, "focus %q, spec %q", focus, spec)
+			}
+		}
+	}
+}
+
+func TestNotContaining(t *testing.T) {
+	re := regexp.MustCompile(notContaining("[Serial]"))
+
+	for _, text := range []string{
+		"", "[", "[[", "]", "Serial", "[Serial", "[Serial[", "[Seria]", "[Serial] ", " [Serial]",
+		"[[Serial]", "[S[Serial]", "[Serial[Serial]", "a [Serial x] b", "[Serial][Serial]", "[Serial]]",
+	} {
+		assert.Equal(t, !strings.Contains(text, "[Serial]"), re.MatchString(text), "text %q", text)
+	}
+}
+
+func TestPhaseGinkgoArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		phase    Phase
+		extra    []string
+		verbose  bool
+		expected []string
+	}{
+		{
+			name:     "single process",
+			phase:    Phase{Parallel: 1},
+			extra:    []string{"--timeout=2h"},
+			expected: []string{"--timeout=2h"},
+		},
+		{
+			name:     "parallel and verbose",
+			phase:    Phase{Parallel: 3},
+			verbose:  true,
+			expected: []string{"--procs=3", "-v"},
+		},
+		{
+			name:     "user label filter",
+			phase:    Phase{Parallel: 2},
+			extra:    []string{"--label-filter=!Slow"},
+			expected: []string{"--label-filter=!Slow", "--procs=2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.phase.ginkgoArgs(tt.extra, tt.verbose))
+		})
+	}
+}
+
+func TestShardSpecs(t *testing.T) {
+	specs := []Spec{
+		{Name: "[sig-apps] Deployment should work [Conformance]"},
+		{Name: "[sig-apps] Deployment should work"},
+		{Name: "[sig-node] Pods (v1) should run"},
+		{Name: "[sig-storage] Volumes should mount"},
+		{Name: "[sig-network] DNS should resolve"},
+	}
+
+	shards, err := shardSpecs(specs, 2)
+	require.NoError(t, err)
+	require.Len(t, shards, 2)
+	assert.Equal(t, PodName+"-shard-1", shards[0].PodName)
+	assert.Equal(t, PodName+"-shard-2", shards[1].PodName)
+
+	// every spec must be selected by exactly one shard, as Ginkgo matches it
+	for _, spec := range specs {
+		matches := 0
+		for _, shard := range shards {
+			if regexp.MustCompile(shard.Focus).MatchString(ginkgoSpecText(spec.Name)) {
+				matches++
+			}
+		}
+
+		assert.Equal(t, 1, matches, "spec %q", spec.Name)
+	}
+
+	t.Run("more shards than specs", func(t *testing.T) {
+		shards, err := shardSpecs(specs[:2], 4)
+		require.NoError(t, err)
+		assert.Len(t, shards, 2)
+	})
+
+	t.Run("no specs", func(t *testing.T) {
+		shards, err := shardSpecs(nil, 4)
+		require.NoError(t, err)
+		require.Len(t, shards, 1)
+		assert.False(t, regexp.MustCompile(shards[0].Focus).MatchString(ginkgoSpecText(specs[0].Name)))
+	})
+
+	t.Run("focus too long", func(t *testing.T) {
+		long := []Spec{}
+		for i := range 3000 {
+			long = append(long, Spec{Name: fmt.Sprintf("[sig-node] %s %d", strings.Repeat("x", 100), i)})
+		}
+
+		_, err := shardSpecs(long, 2)
+		assert.ErrorContains(t, err, "use more shards")
+
+		_, err = shardSpecs(long, 4)
+		assert.NoError(t, err)
+	})
+}
+
+func TestExactFocus(t *testing.T) {
+	names := []string{
+		"[sig-node] Pods should be submitted and removed [NodeConformance] [Conformance]",
+		"[sig-apps] Deployment should work (v1) [Conformance]",
+	}
+
+	focus, err := ExactFocus(names)
+	require.NoError(t, err)
+
+	matches := func(name string) bool {
+		return regexp.MustCompile(focus).MatchString(ginkgoSpecText(name))
+	}
+
+	for _, name := range names {
+		assert.True(t, matches(name), name)
+	}
+
+	assert.False(t, matches("[sig-node] Pods should be submitted and removed [NodeConformance]"))
+	assert.False(t, matches("[sig-apps] Deployment should work (v1) [Conformance] [Slow]"))
+	assert.False(t, matches("[sig-node] Pods should be submitted"))
+}
+
+// ginkgoSpecText returns the text Ginkgo matches focus expressions against.
+func ginkgoSpecText(name string) string {
+	return "Kubernetes e2e suite " + name
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/images"
+	"sigs.k8s.io/hydrophone/pkg/log"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// PrepullDaemonSetName is the name of the DaemonSet pulling the test images on every node.
+	PrepullDaemonSetName = "hydrophone-prepull"
+
+	// prepullDir is where the busybox binary is copied to, so that it can be run by
+	// images that do not contain a shell.
+	prepullDir = "/prepull"
+
+	// prepullInterval is how often the progress of the DaemonSet is checked.
+	prepullInterval = 5 * time.Second
+)
+
+// pullFailures are the container waiting reasons of images that could not be pulled.
+var pullFailures = []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull"}
+
+// TestImages returns the images pulled by the tests, taking --test-repo and
+// --test-repo-list into account. Images that must not be pullable and images the
+// tests pull using their own credentials are omitted.
+func (r *TestRunner) TestImages(ctx context.Context, timeout time.Duration) ([]string, error) {
+	list, err := r.ListImages(ctx, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return images.ResolveAll(slices.DeleteFunc(list, images.RequiresAuth), r.config.TestRepo, r.config.TestRepoList)
+}
+
+// PrepullImages pulls all test images on every node using a DaemonSet. It waits
+// until all nodes pulled the images or the prepull timeout expires, reports the
+// images that could not be pulled per node and removes the DaemonSet afterwards.
+// Failing pulls are not fatal, as the tests will retry them.
+func (r *TestRunner) PrepullImages(ctx context.Context, timeout time.Duration) error {
+	testImages, err := r.TestImages(ctx, timeout)
+	if err != nil {
+		return fmt.Errorf("failed to list images: %w", err)
+	}
+
+	daemonSet, err := r.clientset.AppsV1().DaemonSets(r.config.Namespace).Create(ctx, r.prepullDaemonSet(testImages), metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create DaemonSet: %w", err)
+	}
+
+	log.Printf("Created DaemonSet %s, waiting up to %v for all nodes to pull %d images...", daemonSet.Name, r.config.PrepullTimeout, len(testImages))
+
+	defer func() {
+		err := r.clientset.AppsV1().DaemonSets(r.config.Namespace).Delete(context.WithoutCancel(ctx), daemonSet.Name, metav1.DeleteOptions{
+			PropagationPolicy: ptr.To(metav1.DeletePropagationForeground),
+		})
+		if err != nil && !errors.IsNotFound(err) {
+			log.Errorf("Failed to delete DaemonSet %s: %v", daemonSet.Name, err)
+		} else {
+			log.Printf("Deleted DaemonSet %s.", daemonSet.Name)
+		}
+	}()
+
+	var failures map[string][]string
+
+	err = wait.PollUntilContextTimeout(ctx, prepullInterval, r.config.PrepullTimeout, true, func(ctx context.Context) (bool, error) {
+		var done bool
+		done, failures, err = r.prepullStatus(ctx, daemonSet.Name)
+
+		return done, err
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return err
+	}
+
+	if len(failures) == 0 && err == nil {
+		log.Println("All nodes pulled the test images.")
+		return nil
+	}
+
+	if err != nil {
+		log.Warnf("Not all nodes pulled the test images within %v.", r.config.PrepullTimeout)
+	}
+
+	for _, node := range slices.Sorted(maps.Keys(failures)) {
+		log.Warnf("Node %s failed to pull:", node)
+		for _, failure := range failures[node] {
+			log.Warnf("  %s", failure)
+		}
+	}
+
+	return nil
+}
+
+// prepullStatus checks whether every node finished pulling the images. It
+// returns the images that failed to pull, per node. A node is finished when all
+// images are pulled or every remaining image failed to pull.
+func (r *TestRunner) prepullStatus(ctx context.Context, name string) (bool, map[string][]string, error) {
+	daemonSet, err := r.clientset.AppsV1().DaemonSets(r.config.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get DaemonSet: %w", err)
+	}
+
+	pods, err := r.clientset.CoreV1().Pods(r.config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(daemonSet.Spec.Selector),
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to list Pods: %w", err)
+	}
+
+	done := daemonSet.Status.DesiredNumberScheduled > 0 && int(daemonSet.Status.DesiredNumberScheduled) == len(pods.Items)
+	failures := map[string][]string{}
+
+	for _, pod := range pods.Items {
+		if len(pod.Status.ContainerStatuses) < len(pod.Spec.Containers) {
+			done = false
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			switch {
+			case status.Ready:
+			case status.State.Waiting != nil && slices.Contains(pullFailures, status.State.Waiting.Reason):
+				failures[pod.Spec.NodeName] = append(failures[pod.Spec.NodeName],
+					fmt.Sprintf("%s: %s", status.Image, firstLine(status.State.Waiting.Message)))
+			default:
+				done = false
+			}
+		}
+	}
+
+	return done, failures, nil
+}
+
+// prepullDaemonSet returns the DaemonSet pulling the images on every node. Every
+// image is run as a container sleeping with a statically linked busybox binary,
+// so that the pod only becomes ready once all images are pulled.
+func (r *TestRunner) prepullDaemonSet(testImages []string) *appsv1.DaemonSet {
+	labels := map[string]string{
+		"component": "hydrophone-prepull",
+	}
+
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "prepull-volume",
+			MountPath: prepullDir,
+		},
+	}
+
+	securityContext := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{
+				"ALL",
+			},
+		},
+		RunAsNonRoot: ptr.To(true),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+		RunAsUser: ptr.To(int64(65534)),
+	}
+
+	containers := []corev1.Container{}
+	for i, image := range testImages {
+		containers = append(containers, corev1.Container{
+			Name:            fmt.Sprintf("image-%d", i),
+			Image:           image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{prepullDir + "/busybox", "sleep", "infinity"},
+			VolumeMounts:    volumeMounts,
+			SecurityContext: securityContext,
+		})
+	}
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PrepullDaemonSetName,
+			Namespace: r.config.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name:            "busybox",
+							Image:           r.config.PinnedBusyboxImage(),
+							Command:         []string{"cp", "/bin/busybox", prepullDir + "/busybox"},
+							VolumeMounts:    volumeMounts,
+							SecurityContext: securityContext,
+						},
+					},
+					Containers: containers,
+					Volumes: []corev1.Volume{
+						{
+							Name: "prepull-volume",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
+					},
+					TerminationGracePeriodSeconds: ptr.To(int64(0)),
+					Tolerations: []corev1.Toleration{
+						{
+							// tolerate everything, like the conformance pod
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// firstLine returns the first line of a message.
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	return line
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPrepullDaemonSet(t *testing.T) {
+	config := types.NewDefaultConfiguration()
+	runner := NewTestRunner(config, nil, nil)
+
+	daemonSet := runner.prepullDaemonSet([]string{"registry.k8s.io/pause:3.10", "registry.k8s.io/etcd:3.5.21-0"})
+	spec := daemonSet.Spec.Template.Spec
+
+	assert.Equal(t, PrepullDaemonSetName, daemonSet.Name)
+	assert.Equal(t, config.Namespace, daemonSet.Namespace)
+	assert.Equal(t, daemonSet.Spec.Selector.MatchLabels, daemonSet.Spec.Template.Labels)
+	assert.Equal(t, []corev1.Toleration{{Operator: corev1.TolerationOpExists}}, spec.Tolerations)
+
+	require.Len(t, spec.InitContainers, 1)
+	assert.Equal(t, config.BusyboxImage, spec.InitContainers[0].Image)
+
+	require.Len(t, spec.Containers, 2)
+	for i, image := range []string{"registry.k8s.io/pause:3.10", "registry.k8s.io/etcd:3.5.21-0"} {
+		assert.Equal(t, image, spec.Containers[i].Image)
+		assert.Equal(t, prepullDir+"/busybox", spec.Containers[i].Command[0])
+	}
+}
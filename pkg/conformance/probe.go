@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/common"
+	"sigs.k8s.io/hydrophone/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ProbeServiceName is the name of the Service in front of the network probe servers.
+	ProbeServiceName = "hydrophone-probe"
+
+	// probePort is the port the probe servers listen on.
+	probePort = 8080
+
+	// maxProbeNodes is the maximum number of nodes the network is probed on.
+	maxProbeNodes = 3
+)
+
+// Checks run by the network probe.
+const (
+	ProbePodToPod     = "pod-to-pod"
+	ProbePodToService = "pod-to-service"
+	ProbeDNS          = "dns"
+)
+
+// probeLabels are the labels of all probe pods.
+var probeLabels = map[string]string{
+	"component": "hydrophone-probe",
+}
+
+// probeServerLabels are the labels of the probe servers selected by the probe Service.
+var probeServerLabels = map[string]string{
+	"component": "hydrophone-probe",
+	"role":      "server",
+}
+
+// ProbeResult is the outcome of a single network check between two nodes, or
+// between a node and the probe Service.
+type ProbeResult struct {
+	From   string
+	To     string
+	Check  string
+	Passed bool
+}
+
+// probeTarget is a probe server a probe client connects to.
+type probeTarget struct {
+	node string
+	ip   string
+}
+
+// ProbeNetwork checks pod networking and DNS. It starts a probe server on up to
+// three nodes able to run test pods and a Service in front of them, then runs a
+// probe client on each of these nodes, which connects to every server directly,
+// to the Service by IP and to the Service by name. All probes are removed afterwards.
+func (r *TestRunner) ProbeNetwork(ctx context.Context, timeout time.Duration) ([]ProbeResult, error) {
+	nodes, err := r.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	probeNodes := []string{}
+	for _, node := range nodes.Items {
+		if canRunTestPods(node) && len(probeNodes) < maxProbeNodes {
+			probeNodes = append(probeNodes, node.Name)
+		}
+	}
+
+	switch len(probeNodes) {
+	case 0:
+		return nil, fmt.Errorf("no nodes can run test pods")
+	case 1:
+		log.Warnf("Only node %s can run test pods, networking between nodes cannot be probed.", probeNodes[0])
+	}
+
+	log.Printf("Probing pod networking and DNS on nodes %s...", strings.Join(probeNodes, ", "))
+
+	defer r.deleteProbes(context.WithoutCancel(ctx))
+
+	service, err := r.clientset.CoreV1().Services(r.config.Namespace).Create(ctx, r.probeService(), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Service: %w", err)
+	}
+
+	targets := []probeTarget{}
+	for i, node := range probeNodes {
+		created, err := common.CreatePod(ctx, r.clientset, r.probeServerPod(i, node), timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start probe server on node %s: %w", node, err)
+		}
+
+		server, err := r.clientset.CoreV1().Pods(r.config.Namespace).Get(ctx, created.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Pod: %w", err)
+		}
+
+		targets = append(targets, probeTarget{node: node, ip: server.Status.PodIP})
+	}
+
+	script := probeScript(targets, service.Spec.ClusterIP)
+	results := []ProbeResult{}
+
+	for i, node := range probeNodes {
+		output, err := common.RunPod(ctx, r.clientset, r.probeClientPod(i, node, script), "probe", timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run probe client on node %s: %w", node, err)
+		}
+
+		results = append(results, parseProbeOutput(node, string(output))...)
+	}
+
+	return results, nil
+}
+
+// probeScript returns the shell script of the probe clients. Every check is tried
+// a few times, as servers may take a moment to listen after their pod started.
+// Each check prints a line like "pod-to-pod node-2 ok".
+func probeScript(targets []probeTarget, serviceIP string) string {
+	lines := []string{
+		`probe() { for i in 1 2 3; do wget -q -T 5 -O /dev/null "$3" 2>/dev/null && { echo "$1 $2 ok"; return; }; sleep 2; done; echo "$1 $2 fail"; }`,
+	}
+
+	for _, target := range targets {
+		lines = append(lines, fmt.Sprintf("probe %s %s http://%s/", ProbePodToPod, target.node, joinHostPort(target.ip)))
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("probe %s %s http://%s/", ProbePodToService, ProbeServiceName, joinHostPort(serviceIP)),
+		fmt.Sprintf("probe %s %s http://%s:%d/", ProbeDNS, ProbeServiceName, ProbeServiceName, probePort),
+	)
+
+	return strings.Join(lines, "\n")
+}
+
+// joinHostPort returns the address of the probe port on the IPv4 or IPv6 address.
+func joinHostPort(ip string) string {
+	if strings.Contains(ip, ":") {
+		return fmt.Sprintf("[%s]:%d", ip, probePort)
+	}
+
+	return fmt.Sprintf("%s:%d", ip, probePort)
+}
+
+// parseProbeOutput parses the lines printed by the probe script run on a node.
+func parseProbeOutput(node, output string) []ProbeResult {
+	results := []ProbeResult{}
+
+	for line := range strings.Lines(output) {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || (fields[2] != "ok" && fields[2] != "fail") {
+			continue
+		}
+
+		results = append(results, ProbeResult{From: node, To: fields[1], Check: fields[0], Passed: fields[2] == "ok"})
+	}
+
+	return results
+}
+
+// ProbeFailed returns true if any of the network checks failed.
+func ProbeFailed(results []ProbeResult) bool {
+	return slices.ContainsFunc(results, func(r ProbeResult) bool { return !r.Passed })
+}
+
+// PrintProbeResults writes the outcome of the network checks as a table.
+func PrintProbeResults(w io.Writer, results []ProbeResult) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FROM\tTO\tCHECK\tRESULT")
+	for _, r := range results {
+		result := "PASS"
+		if !r.Passed {
+			result = "FAIL"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.From, r.To, r.Check, result)
+	}
+
+	return tw.Flush()
+}
+
+// deleteProbes removes the probe Service and all probe pods.
+func (r *TestRunner) deleteProbes(ctx context.Context) {
+	err := r.clientset.CoreV1().Services(r.config.Namespace).Delete(ctx, ProbeServiceName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Failed to delete Service %s: %v", ProbeServiceName, err)
+	}
+
+	err = r.clientset.CoreV1().Pods(r.config.Namespace).DeleteCollection(ctx, metav1.DeleteOptions{
+		GracePeriodSeconds: ptr.To(int64(0)),
+	}, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: probeLabels}),
+	})
+	if err != nil {
+		log.Errorf("Failed to delete probe Pods: %v", err)
+	}
+}
+
+// probeService returns the Service in front of all probe servers.
+func (r *TestRunner) probeService() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ProbeServiceName,
+			Namespace: r.config.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: probeServerLabels,
+			Ports: []corev1.ServicePort{
+				{
+					Port:       probePort,
+					TargetPort: intstr.FromInt32(probePort),
+				},
+			},
+		},
+	}
+}
+
+// probeServerPod returns the pod serving HTTP on the given node.
+func (r *TestRunner) probeServerPod(index int, node string) *corev1.Pod {
+	pod := r.probePod(fmt.Sprintf("hydrophone-probe-server-%d", index), node,
+		fmt.Sprintf("mkdir -p /tmp/www && echo ok > /tmp/www/index.html && exec httpd -f -p %d -h /tmp/www", probePort))
+	pod.Labels = maps.Clone(probeServerLabels)
+
+	return pod
+}
+
+// probeClientPod returns the pod running the probe script on the given node.
+func (r *TestRunner) probeClientPod(index int, node, script string) *corev1.Pod {
+	pod := r.probePod(fmt.Sprintf("hydrophone-probe-client-%d", index), node, script)
+	pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+
+	return pod
+}
+
+// probePod returns a busybox pod running the command on the given node.
+func (r *TestRunner) probePod(name, node, command string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: r.config.Namespace,
+			Labels:    maps.Clone(probeLabels),
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:    "probe",
+					Image:   r.config.PinnedBusyboxImage(),
+					Command: []string{"/bin/sh", "-c", command},
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: ptr.To(false),
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{
+								"ALL",
+							},
+						},
+						RunAsNonRoot: ptr.To(true),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+						RunAsUser: ptr.To(int64(65534)),
+					},
+				},
+			},
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{
+								MatchFields: []corev1.NodeSelectorRequirement{
+									{
+										Key:      "metadata.name",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{node},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			TerminationGracePeriodSeconds: ptr.To(int64(0)),
+		},
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeScript(t *testing.T) {
+	script := probeScript([]probeTarget{
+		{node: "worker-1", ip: "10.244.1.5"},
+		{node: "worker-2", ip: "fd00:10:244::5"},
+	}, "10.96.0.42")
+
+	lines := strings.Split(script, "\n")
+	require.Len(t, lines, 5)
+	assert.True(t, strings.HasPrefix(lines[0], "probe() {"))
+	assert.Equal(t, []string{
+		"probe pod-to-pod worker-1 http://10.244.1.5:8080/",
+		"probe pod-to-pod worker-2 http://[fd00:10:244::5]:8080/",
+		"probe pod-to-service hydrophone-probe http://10.96.0.42:8080/",
+		"probe dns hydrophone-probe http://hydrophone-probe:8080/",
+	}, lines[1:])
+}
+
+func TestParseProbeOutput(t *testing.T) {
+	output := "pod-to-pod worker-1 ok\npod-to-pod worker-2 fail\nwget: bad address x\ndns hydrophone-probe ok\n"
+
+	assert.Equal(t, []ProbeResult{
+		{From: "worker-1", To: "worker-1", Check: ProbePodToPod, Passed: true},
+		{From: "worker-1", To: "worker-2", Check: ProbePodToPod, Passed: false},
+		{From: "worker-1", To: "hydrophone-probe", Check: ProbeDNS, Passed: true},
+	}, parseProbeOutput("worker-1", output))
+}
+
+func TestPrintProbeResults(t *testing.T) {
+	results := []ProbeResult{
+		{From: "worker-1", To: "worker-2", Check: ProbePodToPod, Passed: true},
+		{From: "worker-2", To: "hydrophone-probe", Check: ProbeDNS, Passed: false},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, PrintProbeResults(buf, results))
+	assert.Equal(t, `FROM      TO                CHECK       RESULT
+worker-1  worker-2          pod-to-pod  PASS
+worker-2  hydrophone-probe  dns         FAIL
+`, buf.String())
+
+	assert.True(t, ProbeFailed(results))
+	assert.False(t, ProbeFailed(results[:1]))
+}
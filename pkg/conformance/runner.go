@@ -22,18 +22,21 @@ import (
 	"sigs.k8s.io/hydrophone/pkg/types"
 
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 )
 
 type TestRunner struct {
-	config    types.Configuration
-	clientset *kubernetes.Clientset
+	config     types.Configuration
+	restConfig *rest.Config
+	clientset  *kubernetes.Clientset
 }
 
 // NewTestRunner creates a new test runner with the given configuration and Kubernetes client
-func NewTestRunner(config types.Configuration, clientset *kubernetes.Clientset) *TestRunner {
+func NewTestRunner(config types.Configuration, restConfig *rest.Config, clientset *kubernetes.Clientset) *TestRunner {
 	return &TestRunner{
-		config:    config,
-		clientset: clientset,
+		config:     config,
+		restConfig: restConfig,
+		clientset:  clientset,
 	}
 }
 
@@ -67,7 +67,7 @@ func TestNamespacedName(t *testing.T) {
 
 			runner := NewTestRunner(types.Configuration{
 				Namespace: tt.namespace,
-			}, nil)
+			}, nil, nil)
 
 			result := runner.namespacedName(tt.basename)
 			require.Equal(t, tt.expected, result)
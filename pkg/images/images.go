@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+// invalidRegistry is the registry the e2e tests use for images that must not be pullable.
+const invalidRegistry = "invalid.registry.k8s.io/invalid"
+
+// Registry is an entry of the registry list the e2e tests read from KUBE_TEST_REPO_LIST.
+type Registry struct {
+	// Key is the name of the entry in the registry list.
+	Key string
+	// Default is the registry the e2e tests use unless overridden.
+	Default string
+}
+
+// Registries are the entries of the KUBE_TEST_REPO_LIST file, with the defaults of
+// k8s.io/kubernetes/test/utils/image. The invalid registry is intentionally not
+// part of it, as the tests rely on it not being pullable.
+var Registries = []Registry{
+	{Key: "gcAuthenticatedRegistry", Default: "gcr.io/authenticated-image-pulling"},
+	{Key: "promoterE2eRegistry", Default: "registry.k8s.io/e2e-test-images"},
+	{Key: "buildImageRegistry", Default: "registry.k8s.io/build-image"},
+	{Key: "gcEtcdRegistry", Default: "registry.k8s.io"},
+	{Key: "gcRegistry", Default: "registry.k8s.io"},
+	{Key: "sigStorageRegistry", Default: "registry.k8s.io/sig-storage"},
+	{Key: "privateRegistry", Default: "gcr.io/k8s-authenticated-test"},
+	{Key: "dockerLibraryRegistry", Default: "docker.io/library"},
+	{Key: "cloudProviderGcpRegistry", Default: "registry.k8s.io/cloud-provider-gcp"},
+}
+
+// LoadRepoList reads a KUBE_TEST_REPO_LIST file, which maps registry keys to registries.
+func LoadRepoList(filename string) (map[string]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repo list: %w", err)
+	}
+
+	repoList := map[string]string{}
+	if err := yaml.Unmarshal(data, &repoList); err != nil {
+		return nil, fmt.Errorf("invalid repo list: %w", err)
+	}
+
+	return repoList, nil
+}
+
+// Resolve returns the image the e2e tests pull instead of the given default image
+// when KUBE_TEST_REPO_LIST or KUBE_TEST_REPO are set. The repo list takes precedence;
+// the test repo replaces all registries if no repo list is given.
+func Resolve(image, testRepo string, repoList map[string]string) string {
+	registry := registryOf(image)
+	if registry == nil {
+		return image
+	}
+
+	path := strings.TrimPrefix(image, registry.Default)
+
+	if override, ok := repoList[registry.Key]; ok {
+		return strings.TrimSuffix(override, "/") + path
+	}
+
+	if testRepo != "" && repoList == nil {
+		return strings.TrimSuffix(testRepo, "/") + path
+	}
+
+	return image
+}
+
+// ResolveAll resolves the pullable images of the list, reading the repo list from
+// the given file if set. Images that must not be pullable are omitted.
+func ResolveAll(list []string, testRepo, repoListFile string) ([]string, error) {
+	var repoList map[string]string
+	if repoListFile != "" {
+		var err error
+		if repoList, err = LoadRepoList(repoListFile); err != nil {
+			return nil, err
+		}
+	}
+
+	resolved := []string{}
+	for _, image := range list {
+		if image != "" && Pullable(image) {
+			resolved = append(resolved, Resolve(image, testRepo, repoList))
+		}
+	}
+
+	return resolved, nil
+}
+
+// RequiresAuth returns true for images of the registries the e2e tests only pull
+// using their own credentials.
+func RequiresAuth(image string) bool {
+	registry := registryOf(image)
+	return registry != nil && (registry.Key == "gcAuthenticatedRegistry" || registry.Key == "privateRegistry")
+}
+
+// registryOf returns the entry of the registry list an image is pulled from, or nil.
+// Etcd shares its registry with other images, but has a dedicated entry.
+func registryOf(image string) *Registry {
+	var match *Registry
+
+	for i, r := range Registries {
+		if !strings.HasPrefix(image, r.Default+"/") || (match != nil && len(match.Default) >= len(r.Default)) {
+			continue
+		}
+
+		match = &Registries[i]
+	}
+
+	if match != nil && match.Key == "gcEtcdRegistry" && !strings.HasPrefix(image, match.Default+"/etcd:") {
+		for i, r := range Registries {
+			if r.Key == "gcRegistry" {
+				match = &Registries[i]
+			}
+		}
+	}
+
+	return match
+}
+
+// Rewrite replaces the registry host of an image or repository with the mirror,
+// keeping the path, e.g. registry.k8s.io/pause:3.10 becomes mirror.example.com/pause:3.10.
+func Rewrite(image, mirror string) string {
+	mirror = strings.TrimSuffix(mirror, "/")
+
+	_, path, found := strings.Cut(image, "/")
+	if !found {
+		return mirror
+	}
+
+	return mirror + "/" + path
+}
+
+// RepoList returns the entries of a KUBE_TEST_REPO_LIST file that redirects every
+// registry used by the given images to the mirror, in the order of Registries.
+// Images that are not pulled from any known registry are returned as unmapped.
+func RepoList(images []string, mirror string) ([]Registry, []string) {
+	used := map[string]bool{}
+	unmapped := []string{}
+
+	for _, image := range images {
+		registry := registryOf(image)
+
+		switch {
+		case registry != nil:
+			used[registry.Key] = true
+		case Pullable(image):
+			unmapped = append(unmapped, image)
+		}
+	}
+
+	list := []Registry{}
+	for _, r := range Registries {
+		if used[r.Key] {
+			list = append(list, Registry{Key: r.Key, Default: Rewrite(r.Default, mirror)})
+		}
+	}
+
+	return list, unmapped
+}
+
+// Pullable returns false for images the e2e tests expect to be unpullable.
+func Pullable(image string) bool {
+	return !strings.HasPrefix(image, invalidRegistry+"/")
+}
+
+// Print writes the images in the given output format. The repo-list format needs a mirror.
+func Print(w io.Writer, images []string, output, mirror string) error {
+	switch output {
+	case types.OutputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(images)
+
+	case types.OutputYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(images); err != nil {
+			return err
+		}
+
+		return encoder.Close()
+
+	case types.OutputRepoList:
+		list, unmapped := RepoList(images, mirror)
+		for _, image := range unmapped {
+			log.Warnf("Cannot redirect %s, it is not pulled from a registry of the repo list.", image)
+		}
+
+		for _, r := range list {
+			if _, err := fmt.Fprintf(w, "%s: %s\n", r.Key, r.Default); err != nil {
+				return err
+			}
+		}
+
+		return nil
+
+	default:
+		for _, image := range images {
+			if _, err := fmt.Fprintln(w, image); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
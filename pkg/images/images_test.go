@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"bytes"
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testImages = []string{
+	"gcr.io/k8s-authenticated-test/agnhost:2.6",
+	"invalid.registry.k8s.io/invalid/alpine:3.1",
+	"quay.io/example/tool:1.0",
+	"registry.k8s.io/e2e-test-images/agnhost:2.53",
+	"registry.k8s.io/etcd:3.5.21-0",
+	"registry.k8s.io/pause:3.10",
+	"registry.k8s.io/sig-storage/csi-provisioner:v5.2.0",
+}
+
+func TestRewrite(t *testing.T) {
+	assert.Equal(t, "mirror.example.com:5000/k8s/pause:3.10", Rewrite("registry.k8s.io/pause:3.10", "mirror.example.com:5000/k8s/"))
+	assert.Equal(t, "mirror.example.com/e2e-test-images", Rewrite("registry.k8s.io/e2e-test-images", "mirror.example.com"))
+	assert.Equal(t, "mirror.example.com", Rewrite("registry.k8s.io", "mirror.example.com"))
+}
+
+func TestResolve(t *testing.T) {
+	repoList := map[string]string{
+		"gcRegistry":          "mirror.example.com/k8s/",
+		"promoterE2eRegistry": "mirror.example.com/e2e",
+	}
+
+	assert.Equal(t, "mirror.example.com/k8s/pause:3.10", Resolve("registry.k8s.io/pause:3.10", "", repoList))
+	assert.Equal(t, "mirror.example.com/e2e/agnhost:2.53", Resolve("registry.k8s.io/e2e-test-images/agnhost:2.53", "test.example.com", repoList))
+	assert.Equal(t, "registry.k8s.io/etcd:3.5.21-0", Resolve("registry.k8s.io/etcd:3.5.21-0", "", repoList))
+	assert.Equal(t, "test.example.com/etcd:3.5.21-0", Resolve("registry.k8s.io/etcd:3.5.21-0", "test.example.com", nil))
+	assert.Equal(t, "quay.io/example/tool:1.0", Resolve("quay.io/example/tool:1.0", "test.example.com", nil))
+}
+
+func TestResolveAll(t *testing.T) {
+	resolved, err := ResolveAll(testImages, "test.example.com", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"test.example.com/agnhost:2.6",
+		"quay.io/example/tool:1.0",
+		"test.example.com/agnhost:2.53",
+		"test.example.com/etcd:3.5.21-0",
+		"test.example.com/pause:3.10",
+		"test.example.com/csi-provisioner:v5.2.0",
+	}, resolved)
+
+	_, err = ResolveAll(testImages, "", "missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestRequiresAuth(t *testing.T) {
+	assert.True(t, RequiresAuth("gcr.io/k8s-authenticated-test/agnhost:2.6"))
+	assert.True(t, RequiresAuth("gcr.io/authenticated-image-pulling/alpine:3.7"))
+	assert.False(t, RequiresAuth("registry.k8s.io/pause:3.10"))
+	assert.False(t, RequiresAuth("quay.io/example/tool:1.0"))
+}
+
+func TestRepoList(t *testing.T) {
+	list, unmapped := RepoList(testImages, "mirror.example.com")
+
+	assert.Equal(t, []Registry{
+		{Key: "promoterE2eRegistry", Default: "mirror.example.com/e2e-test-images"},
+		{Key: "gcEtcdRegistry", Default: "mirror.example.com"},
+		{Key: "gcRegistry", Default: "mirror.example.com"},
+		{Key: "sigStorageRegistry", Default: "mirror.example.com/sig-storage"},
+		{Key: "privateRegistry", Default: "mirror.example.com/k8s-authenticated-test"},
+	}, list)
+	assert.Equal(t, []string{"quay.io/example/tool:1.0"}, unmapped)
+}
+
+func TestPrint(t *testing.T) {
+	testCases := []struct {
+		output   string
+		expected string
+	}{
+		{
+			output:   types.OutputText,
+			expected: "registry.k8s.io/etcd:3.5.21-0\nregistry.k8s.io/pause:3.10\n",
+		},
+		{
+			output:   types.OutputJSON,
+			expected: "[\n  \"registry.k8s.io/etcd:3.5.21-0\",\n  \"registry.k8s.io/pause:3.10\"\n]\n",
+		},
+		{
+			output:   types.OutputYAML,
+			expected: "- registry.k8s.io/etcd:3.5.21-0\n- registry.k8s.io/pause:3.10\n",
+		},
+		{
+			output:   types.OutputRepoList,
+			expected: "gcEtcdRegistry: localhost:5000\ngcRegistry: localhost:5000\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.output, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Print(&buf, testImages[4:6], tc.output, "localhost:5000"))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
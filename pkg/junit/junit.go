@@ -0,0 +1,375 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package junit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReportFile is the name of the JUnit report written by the e2e test suite.
+const ReportFile = "junit_01.xml"
+
+// Test case states as written by Ginkgo into the status attribute.
+const (
+	StatusPassed      = "passed"
+	StatusSkipped     = "skipped"
+	StatusPending     = "pending"
+	StatusFailed      = "failed"
+	StatusTimedout    = "timedout"
+	StatusPanicked    = "panicked"
+	StatusInterrupted = "interrupted"
+	StatusAborted     = "aborted"
+)
+
+// TestSuites is the root element of a JUnit report as written by Ginkgo.
+type TestSuites struct {
+	XMLName    xml.Name    `xml:"testsuites"`
+	Tests      int         `xml:"tests,attr"`
+	Disabled   int         `xml:"disabled,attr"`
+	Errors     int         `xml:"errors,attr"`
+	Failures   int         `xml:"failures,attr"`
+	Time       float64     `xml:"time,attr"`
+	TestSuites []TestSuite `xml:"testsuite"`
+}
+
+// TestSuite contains the results of a single test suite run.
+type TestSuite struct {
+	Name       string      `xml:"name,attr"`
+	Package    string      `xml:"package,attr"`
+	Tests      int         `xml:"tests,attr"`
+	Disabled   int         `xml:"disabled,attr"`
+	Skipped    int         `xml:"skipped,attr"`
+	Errors     int         `xml:"errors,attr"`
+	Failures   int         `xml:"failures,attr"`
+	Time       float64     `xml:"time,attr"`
+	Timestamp  string      `xml:"timestamp,attr"`
+	Properties *Properties `xml:"properties,omitempty"`
+	TestCases  []TestCase  `xml:"testcase"`
+}
+
+// Properties holds the suite configuration as reported by Ginkgo.
+type Properties struct {
+	Properties []Property `xml:"property"`
+}
+
+// Property is a single key/value pair of suite configuration.
+type Property struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// TestCase is the result of a single spec.
+type TestCase struct {
+	Name      string   `xml:"name,attr"`
+	Classname string   `xml:"classname,attr"`
+	Status    string   `xml:"status,attr"`
+	Time      float64  `xml:"time,attr"`
+	Skipped   *Skipped `xml:"skipped,omitempty"`
+	Error     *Failure `xml:"error,omitempty"`
+	Failure   *Failure `xml:"failure,omitempty"`
+	// Flaky records failures of earlier attempts of a spec that passed when retried.
+	Flaky     []Failure `xml:"flakyFailure,omitempty"`
+	SystemOut string    `xml:"system-out,omitempty"`
+	SystemErr string    `xml:"system-err,omitempty"`
+}
+
+// Skipped marks a test case as not executed.
+type Skipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// Failure describes why a test case failed or errored.
+type Failure struct {
+	Message     string `xml:"message,attr"`
+	Type        string `xml:"type,attr"`
+	Description string `xml:",chardata"`
+}
+
+// suiteNodes are the prefixes of test cases reported for Ginkgo's suite-level setup
+// and teardown nodes, which are not specs.
+var suiteNodes = []string{
+	"[BeforeSuite]",
+	"[SynchronizedBeforeSuite]",
+	"[AfterSuite]",
+	"[SynchronizedAfterSuite]",
+	"[ReportBeforeSuite]",
+	"[ReportAfterSuite]",
+	"[DeferCleanup (Suite)]",
+}
+
+// IsSpec returns false if the test case represents a suite-level node instead of a spec.
+func (tc *TestCase) IsSpec() bool {
+	for _, prefix := range suiteNodes {
+		if strings.HasPrefix(tc.Name, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SpecName returns the full text of the spec, as used in focus and skip expressions,
+// without the node type prefix Ginkgo adds to the test case name.
+func (tc *TestCase) SpecName() string {
+	return strings.TrimPrefix(tc.Name, "[It] ")
+}
+
+// Failed returns true if the test case did not succeed.
+func (tc *TestCase) Failed() bool {
+	return tc.Failure != nil || tc.Error != nil
+}
+
+// IsSkipped returns true if the test case was not executed.
+func (tc *TestCase) IsSkipped() bool {
+	return tc.Skipped != nil || tc.Status == StatusSkipped || tc.Status == StatusPending
+}
+
+// LoadFile reads a JUnit report from disk.
+func LoadFile(filename string) (*TestSuites, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	suites := &TestSuites{}
+	if err := xml.Unmarshal(data, suites); err != nil {
+		return nil, fmt.Errorf("invalid JUnit report %s: %w", filename, err)
+	}
+
+	return suites, nil
+}
+
+// WriteFile stores a JUnit report on disk.
+func WriteFile(filename string, suites *TestSuites) error {
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JUnit report: %w", err)
+	}
+
+	data = append([]byte(xml.Header), data...)
+	data = append(data, '\n')
+
+	return os.WriteFile(filename, data, 0o644)
+}
+
+// Merge combines the reports of multiple test runs of the same suite into a single
+// report containing one test suite. Specs are matched by name; a spec that was
+// executed in any report takes precedence over a skipped one, and a failure takes
+// precedence over a success.
+func Merge(reports ...*TestSuites) *TestSuites {
+	merged := TestSuite{}
+	index := map[string]int{}
+	succeeded := true
+
+	for _, report := range reports {
+		for _, suite := range report.TestSuites {
+			if merged.Name == "" {
+				merged.Name = suite.Name
+				merged.Package = suite.Package
+				merged.Timestamp = suite.Timestamp
+				merged.Properties = suite.Properties
+			}
+
+			merged.Time += suite.Time
+
+			if suite.property("SuiteSucceeded") == "false" {
+				succeeded = false
+			}
+
+			for _, tc := range suite.TestCases {
+				i, exists := index[tc.Name]
+				if !exists {
+					index[tc.Name] = len(merged.TestCases)
+					merged.TestCases = append(merged.TestCases, tc)
+					continue
+				}
+
+				if rank(&tc) > rank(&merged.TestCases[i]) {
+					merged.TestCases[i] = tc
+				}
+			}
+		}
+	}
+
+	if merged.Properties != nil {
+		merged.Properties = merged.Properties.with("SuiteSucceeded", fmt.Sprintf("%t", succeeded))
+	}
+
+	merged.recount()
+
+	result := &TestSuites{
+		Time:       merged.Time,
+		TestSuites: []TestSuite{merged},
+	}
+	result.recount()
+
+	return result
+}
+
+// FailedSpecs returns all specs that failed in the report.
+func (s *TestSuites) FailedSpecs() []TestCase {
+	failed := []TestCase{}
+
+	for _, suite := range s.TestSuites {
+		for _, tc := range suite.TestCases {
+			if tc.IsSpec() && tc.Failed() {
+				failed = append(failed, tc)
+			}
+		}
+	}
+
+	return failed
+}
+
+// MergeRetry applies the results of a rerun of failed specs to the original report.
+// Specs that passed when retried replace the original failures and keep them as
+// flaky failures; specs that failed again are replaced by their latest failure.
+func MergeRetry(original, retry *TestSuites) *TestSuites {
+	retried := map[string]TestCase{}
+
+	for _, suite := range retry.TestSuites {
+		for _, tc := range suite.TestCases {
+			if tc.IsSpec() && !tc.IsSkipped() {
+				retried[tc.Name] = tc
+			}
+		}
+	}
+
+	merged := &TestSuites{}
+
+	for _, suite := range original.TestSuites {
+		suite.TestCases = append([]TestCase{}, suite.TestCases...)
+
+		for i, tc := range suite.TestCases {
+			result, ok := retried[tc.Name]
+			if !ok || !tc.Failed() {
+				continue
+			}
+
+			if !result.Failed() {
+				result.Flaky = append(tc.Flaky, flakyFailure(&tc))
+			}
+
+			suite.TestCases[i] = result
+		}
+
+		suite.recount()
+		merged.TestSuites = append(merged.TestSuites, suite)
+		merged.Time += suite.Time
+	}
+
+	merged.recount()
+
+	return merged
+}
+
+// flakyFailure returns the failure of a test case to be recorded as flaky.
+func flakyFailure(tc *TestCase) Failure {
+	if tc.Failure != nil {
+		return *tc.Failure
+	}
+
+	return *tc.Error
+}
+
+// rank orders test case results by how much information they carry.
+func rank(tc *TestCase) int {
+	switch {
+	case tc.Failed():
+		return 2
+	case tc.IsSkipped():
+		return 0
+	default:
+		return 1
+	}
+}
+
+// recount updates the suite's counters based on its test cases.
+func (s *TestSuite) recount() {
+	s.Tests = len(s.TestCases)
+	s.Disabled = 0
+	s.Skipped = 0
+	s.Errors = 0
+	s.Failures = 0
+
+	for _, tc := range s.TestCases {
+		switch {
+		case tc.Status == StatusPending:
+			s.Disabled++
+		case tc.IsSkipped():
+			s.Skipped++
+		case tc.Error != nil:
+			s.Errors++
+		case tc.Failure != nil:
+			s.Failures++
+		}
+	}
+}
+
+// recount updates the report's counters based on its test suites.
+func (s *TestSuites) recount() {
+	s.Tests = 0
+	s.Disabled = 0
+	s.Errors = 0
+	s.Failures = 0
+
+	for _, suite := range s.TestSuites {
+		s.Tests += suite.Tests
+		s.Disabled += suite.Disabled
+		s.Errors += suite.Errors
+		s.Failures += suite.Failures
+	}
+}
+
+// property returns the value of the given suite property, or an empty string.
+func (s *TestSuite) property(name string) string {
+	if s.Properties == nil {
+		return ""
+	}
+
+	for _, p := range s.Properties.Properties {
+		if p.Name == name {
+			return p.Value
+		}
+	}
+
+	return ""
+}
+
+// with returns a copy of the properties with the given property set to value.
+func (p *Properties) with(name, value string) *Properties {
+	result := &Properties{}
+	found := false
+
+	for _, prop := range p.Properties {
+		if prop.Name == name {
+			prop.Value = value
+			found = true
+		}
+
+		result.Properties = append(result.Properties, prop)
+	}
+
+	if !found {
+		result.Properties = append(result.Properties, Property{Name: name, Value: value})
+	}
+
+	return result
+}
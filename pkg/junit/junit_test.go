@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package junit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const parallelReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" disabled="0" errors="0" failures="0" time="12.5">
+  <testsuite name="Kubernetes e2e suite" package="/usr/local/bin" tests="3" disabled="0" skipped="1" errors="0" failures="0" time="12.5" timestamp="2026-01-01T10:00:00">
+    <properties>
+      <property name="SuiteSucceeded" value="true"></property>
+    </properties>
+    <testcase name="[sig-apps] Deployment should work [Conformance]" classname="Kubernetes e2e suite" status="passed" time="10"></testcase>
+    <testcase name="[sig-node] Pods should be evicted [Serial] [Conformance]" classname="Kubernetes e2e suite" status="skipped" time="0">
+      <skipped message="skipped"></skipped>
+    </testcase>
+    <testcase name="[SynchronizedBeforeSuite]" classname="Kubernetes e2e suite" status="passed" time="2.5"></testcase>
+  </testsuite>
+</testsuites>`
+
+const serialReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="3" disabled="0" errors="0" failures="1" time="20">
+  <testsuite name="Kubernetes e2e suite" package="/usr/local/bin" tests="3" disabled="0" skipped="1" errors="0" failures="1" time="20" timestamp="2026-01-01T11:00:00">
+    <properties>
+      <property name="SuiteSucceeded" value="false"></property>
+    </properties>
+    <testcase name="[sig-apps] Deployment should work [Conformance]" classname="Kubernetes e2e suite" status="skipped" time="0">
+      <skipped message="skipped"></skipped>
+    </testcase>
+    <testcase name="[sig-node] Pods should be evicted [Serial] [Conformance]" classname="Kubernetes e2e suite" status="failed" time="18">
+      <failure message="timed out" type="failed">pods.go:123</failure>
+    </testcase>
+    <testcase name="[SynchronizedBeforeSuite]" classname="Kubernetes e2e suite" status="passed" time="2"></testcase>
+  </testsuite>
+</testsuites>`
+
+func writeReport(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "junit_01.xml")
+	require.NoError(t, WriteFile(filename, parse(t, content)))
+
+	return filename
+}
+
+func parse(t *testing.T, content string) *TestSuites {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "report.xml")
+	require.NoError(t, os.WriteFile(filename, []byte(content), 0o644))
+
+	suites, err := LoadFile(filename)
+	require.NoError(t, err)
+
+	return suites
+}
+
+func TestLoadAndWriteFile(t *testing.T) {
+	filename := writeReport(t, parallelReport)
+
+	suites, err := LoadFile(filename)
+	require.NoError(t, err)
+	require.Len(t, suites.TestSuites, 1)
+
+	suite := suites.TestSuites[0]
+	assert.Equal(t, "Kubernetes e2e suite", suite.Name)
+	assert.Equal(t, 3, suite.Tests)
+	require.Len(t, suite.TestCases, 3)
+	assert.True(t, suite.TestCases[1].IsSkipped())
+	assert.False(t, suite.TestCases[0].Failed())
+}
+
+func TestMerge(t *testing.T) {
+	merged := Merge(parse(t, parallelReport), parse(t, serialReport))
+
+	require.Len(t, merged.TestSuites, 1)
+	suite := merged.TestSuites[0]
+
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 0, suite.Skipped)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, 1, merged.Failures)
+	assert.InDelta(t, 32.5, suite.Time, 0.001)
+	assert.Equal(t, "2026-01-01T10:00:00", suite.Timestamp)
+	assert.Equal(t, "false", suite.property("SuiteSucceeded"))
+
+	require.Len(t, suite.TestCases, 3)
+	assert.Equal(t, StatusPassed, suite.TestCases[0].Status)
+	assert.Equal(t, StatusFailed, suite.TestCases[1].Status)
+	assert.Equal(t, "timed out", suite.TestCases[1].Failure.Message)
+	assert.InDelta(t, 2.5, suite.TestCases[2].Time, 0.001)
+}
+
+const retryReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="2" disabled="0" errors="0" failures="0" time="15">
+  <testsuite name="Kubernetes e2e suite" package="/usr/local/bin" tests="2" disabled="0" skipped="0" errors="0" failures="0" time="15" timestamp="2026-01-02T10:00:00">
+    <testcase name="[sig-node] Pods should be evicted [Serial] [Conformance]" classname="Kubernetes e2e suite" status="passed" time="14"></testcase>
+    <testcase name="[SynchronizedBeforeSuite]" classname="Kubernetes e2e suite" status="passed" time="1"></testcase>
+  </testsuite>
+</testsuites>`
+
+func TestSpecName(t *testing.T) {
+	testcases := []struct {
+		name     string
+		expected string
+		isSpec   bool
+	}{
+		{
+			name:     "[It] [sig-apps] Deployment should work [Conformance]",
+			expected: "[sig-apps] Deployment should work [Conformance]",
+			isSpec:   true,
+		},
+		{
+			name:     "[sig-apps] Deployment should work [Conformance]",
+			expected: "[sig-apps] Deployment should work [Conformance]",
+			isSpec:   true,
+		},
+		{
+			name:     "[SynchronizedBeforeSuite]",
+			expected: "[SynchronizedBeforeSuite]",
+			isSpec:   false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			testCase := TestCase{Name: tc.name}
+			assert.Equal(t, tc.expected, testCase.SpecName())
+			assert.Equal(t, tc.isSpec, testCase.IsSpec())
+		})
+	}
+}
+
+func TestFailedSpecs(t *testing.T) {
+	assert.Empty(t, parse(t, parallelReport).FailedSpecs())
+
+	failed := parse(t, serialReport).FailedSpecs()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "[sig-node] Pods should be evicted [Serial] [Conformance]", failed[0].Name)
+}
+
+func TestMergeRetry(t *testing.T) {
+	original := Merge(parse(t, parallelReport), parse(t, serialReport))
+	final := MergeRetry(original, parse(t, retryReport))
+
+	assert.Empty(t, final.FailedSpecs())
+	assert.Equal(t, 0, final.Failures)
+	require.Len(t, final.TestSuites, 1)
+
+	suite := final.TestSuites[0]
+	require.Len(t, suite.TestCases, 3)
+	assert.Equal(t, 0, suite.Failures)
+
+	// passed specs of the original run are untouched
+	assert.Equal(t, original.TestSuites[0].TestCases[0], suite.TestCases[0])
+
+	// the retried spec passed and keeps its original failure as flaky
+	retried := suite.TestCases[1]
+	assert.Equal(t, StatusPassed, retried.Status)
+	assert.Nil(t, retried.Failure)
+	require.Len(t, retried.Flaky, 1)
+	assert.Equal(t, "timed out", retried.Flaky[0].Message)
+
+	// the original report is not modified
+	assert.Len(t, original.FailedSpecs(), 1)
+}
+
+func TestMergeRetryFailedAgain(t *testing.T) {
+	original := parse(t, serialReport)
+	final := MergeRetry(original, parse(t, serialReport))
+
+	failed := final.FailedSpecs()
+	require.Len(t, failed, 1)
+	assert.Empty(t, failed[0].Flaky)
+	assert.Equal(t, 1, final.Failures)
+}
@@ -65,6 +65,11 @@ func Errorf(format string, v ...any) {
 	slog.Error(fmt.Sprintf(format, v...))
 }
 
+// Warnf logs a warning message with formatted output.
+func Warnf(format string, v ...any) {
+	slog.Warn(fmt.Sprintf(format, v...))
+}
+
 // Printf logs an info message with formatted output.
 func Printf(format string, v ...any) {
 	slog.Info(fmt.Sprintf(format, v...))
@@ -0,0 +1,533 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Media types of the manifests supported by the client.
+const (
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+	MediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+)
+
+// manifestMediaTypes are accepted when fetching manifests, most specific first.
+var manifestMediaTypes = []string{
+	MediaTypeOCIIndex,
+	MediaTypeDockerManifestList,
+	MediaTypeOCIManifest,
+	MediaTypeDockerManifest,
+}
+
+// ErrNotFound is returned if a manifest or blob does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Descriptor describes a manifest or blob referenced by another manifest.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	URLs        []string          `json:"urls,omitempty"`
+	Platform    *Platform         `json:"platform,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Platform is the platform an image of a manifest list is built for.
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// String returns the platform in the os/arch[/variant] notation.
+func (p *Platform) String() string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+
+	return s
+}
+
+// Manifest is an image manifest or a manifest list, as stored in the registry.
+type Manifest struct {
+	MediaType string `json:"mediaType"`
+	// Config and Layers are set for image manifests.
+	Config *Descriptor  `json:"config,omitempty"`
+	Layers []Descriptor `json:"layers,omitempty"`
+	// Manifests is set for manifest lists.
+	Manifests []Descriptor `json:"manifests,omitempty"`
+
+	// Digest is the digest of the raw manifest.
+	Digest string `json:"-"`
+	// Raw is the manifest as returned by the registry.
+	Raw []byte `json:"-"`
+}
+
+// IsIndex returns true if the manifest is a manifest list.
+func (m *Manifest) IsIndex() bool {
+	return m.MediaType == MediaTypeOCIIndex || m.MediaType == MediaTypeDockerManifestList
+}
+
+// Client talks to container registries using the registry v2 API. It supports
+// anonymous and basic authentication as well as bearer tokens.
+type Client struct {
+	http        *http.Client
+	plainHTTP   map[string]bool
+	credentials map[string][2]string
+
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+// NewClient returns a client for the given HTTP client, or the default one if nil.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		http:        httpClient,
+		plainHTTP:   map[string]bool{},
+		credentials: map[string][2]string{},
+		tokens:      map[string]string{},
+	}
+}
+
+// SetPlainHTTP makes the client use plain HTTP for the given registry. Registries
+// on localhost always use plain HTTP.
+func (c *Client) SetPlainHTTP(registry string) {
+	c.plainHTTP[registry] = true
+}
+
+// SetCredentials sets the username and password to authenticate against the given registry.
+func (c *Client) SetCredentials(registry, username, password string) {
+	c.credentials[registry] = [2]string{username, password}
+}
+
+// Manifest fetches the manifest of the referenced image.
+func (c *Client) Manifest(ctx context.Context, ref Reference) (*Manifest, error) {
+	resp, err := c.do(ctx, http.MethodGet, ref, c.url(ref, "manifests", ref.Identifier()), nil, false, func(req *http.Request) {
+		req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest of %s: %w", ref, err)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest of %s: %w", ref, err)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); manifest.MediaType == "" {
+		manifest.MediaType, _, _ = strings.Cut(contentType, ";")
+	}
+
+	manifest.Raw = data
+	manifest.Digest = Digest(data)
+
+	if ref.Digest != "" && ref.Digest != manifest.Digest {
+		return nil, fmt.Errorf("manifest of %s has digest %s", ref, manifest.Digest)
+	}
+
+	return manifest, nil
+}
+
+// PutManifest uploads a manifest. The reference must contain a tag or digest.
+func (c *Client) PutManifest(ctx context.Context, ref Reference, manifest *Manifest) error {
+	resp, err := c.do(ctx, http.MethodPut, ref, c.url(ref, "manifests", ref.Identifier()), manifest.Raw, true, func(req *http.Request) {
+		req.Header.Set("Content-Type", manifest.MediaType)
+	})
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// BlobExists checks whether the repository of the reference contains the blob.
+func (c *Client) BlobExists(ctx context.Context, ref Reference, digest string) (bool, error) {
+	resp, err := c.do(ctx, http.MethodHead, ref, c.url(ref, "blobs", digest), nil, true, nil)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, resp.Body.Close()
+}
+
+// Blob downloads a blob from the repository of the reference.
+func (c *Client) Blob(ctx context.Context, ref Reference, digest string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, ref, c.url(ref, "blobs", digest), nil, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// Tags lists all tags of the repository of the reference, following pagination.
+func (c *Client) Tags(ctx context.Context, ref Reference) ([]string, error) {
+	tags := []string{}
+	next := c.url(ref, "tags", "list")
+
+	for next != "" {
+		resp, err := c.do(ctx, http.MethodGet, ref, next, nil, false, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		list := struct {
+			Tags []string `json:"tags"`
+		}{}
+
+		err = json.NewDecoder(resp.Body).Decode(&list)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag list of %s: %w", ref, err)
+		}
+
+		tags = append(tags, list.Tags...)
+
+		if next, err = nextPage(resp); err != nil {
+			return nil, fmt.Errorf("invalid tag list of %s: %w", ref, err)
+		}
+	}
+
+	return tags, nil
+}
+
+// PutBlob uploads a blob of the given size into the repository of the reference
+// using a monolithic upload.
+func (c *Client) PutBlob(ctx context.Context, ref Reference, desc Descriptor, content io.Reader) error {
+	resp, err := c.do(ctx, http.MethodPost, ref, c.url(ref, "blobs", "uploads/"), nil, true, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	location, err := resp.Location()
+	if err != nil {
+		return fmt.Errorf("registry did not return an upload location for %s: %w", ref, err)
+	}
+
+	query := location.Query()
+	query.Set("digest", desc.Digest)
+	location.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, location.String(), content)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = desc.Size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	c.authorize(req, ref, true)
+
+	resp, err = c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload blob %s to %s: %w", desc.Digest, ref, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return responseError(resp, fmt.Sprintf("blob %s of %s", desc.Digest, ref))
+	}
+
+	return nil
+}
+
+// url returns the API URL of a manifest or blob in the repository of the reference.
+func (c *Client) url(ref Reference, kind, name string) string {
+	scheme := "https"
+	if c.isPlainHTTP(ref.Registry) {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s/v2/%s/%s/%s", scheme, ref.host(), ref.Repository, kind, name)
+}
+
+// isPlainHTTP returns true if the registry must be accessed using plain HTTP.
+func (c *Client) isPlainHTTP(registry string) bool {
+	if c.plainHTTP[registry] {
+		return true
+	}
+
+	host := registry
+	if h, _, err := net.SplitHostPort(registry); err == nil {
+		host = h
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// do sends a request to the registry, authenticating if the registry asks for it.
+// It returns ErrNotFound for 404 responses and an error for all other failures.
+func (c *Client) do(ctx context.Context, method string, ref Reference, rawURL string, body []byte, push bool, prepare func(*http.Request)) (*http.Response, error) {
+	newRequest := func() (*http.Request, error) {
+		var content io.Reader
+		if body != nil {
+			content = bytes.NewReader(body)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, rawURL, content)
+		if err != nil {
+			return nil, err
+		}
+
+		if prepare != nil {
+			prepare(req)
+		}
+
+		c.authorize(req, ref, push)
+
+		return req, nil
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to %s failed: %w", ref.Registry, err)
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+
+		if err := c.authenticate(ctx, ref, push, resp.Header.Get("WWW-Authenticate")); err != nil {
+			return nil, err
+		}
+
+		if req, err = newRequest(); err != nil {
+			return nil, err
+		}
+
+		if resp, err = c.http.Do(req); err != nil {
+			return nil, fmt.Errorf("request to %s failed: %w", ref.Registry, err)
+		}
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %w", ref, ErrNotFound)
+	}
+
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, responseError(resp, ref.String())
+	}
+
+	return resp, nil
+}
+
+// authorize adds the cached credentials for the repository of the reference to the request.
+func (c *Client) authorize(req *http.Request, ref Reference, push bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if token, ok := c.tokens[tokenKey(ref, push)]; ok {
+		if token == "" {
+			credentials := c.credentials[ref.Registry]
+			req.SetBasicAuth(credentials[0], credentials[1])
+		} else {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+	}
+}
+
+// authenticate handles the authentication challenge of a registry. For basic
+// authentication the configured credentials are used, for bearer authentication a
+// token is requested from the authorization server.
+func (c *Client) authenticate(ctx context.Context, ref Reference, push bool, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if _, ok := c.credentials[ref.Registry]; !ok {
+			return fmt.Errorf("%s requires credentials", ref.Registry)
+		}
+
+		c.mu.Lock()
+		c.tokens[tokenKey(ref, push)] = ""
+		c.mu.Unlock()
+
+		return nil
+
+	case "bearer":
+		token, err := c.fetchToken(ctx, ref, push, params)
+		if err != nil {
+			return err
+		}
+
+		c.mu.Lock()
+		c.tokens[tokenKey(ref, push)] = token
+		c.mu.Unlock()
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported authentication scheme %q of %s", scheme, ref.Registry)
+	}
+}
+
+// fetchToken requests a bearer token for the repository of the reference.
+func (c *Client) fetchToken(ctx context.Context, ref Reference, push bool, params map[string]string) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid authentication realm %q of %s", params["realm"], ref.Registry)
+	}
+
+	actions := "pull"
+	if push {
+		actions = "pull,push"
+	}
+
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:%s", ref.Repository, actions))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	if credentials, ok := c.credentials[ref.Registry]; ok {
+		req.SetBasicAuth(credentials[0], credentials[1])
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request token for %s: %w", ref.Registry, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", responseError(resp, "token for "+ref.String())
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("invalid token response of %s: %w", ref.Registry, err)
+	}
+
+	if token.Token != "" {
+		return token.Token, nil
+	}
+
+	return token.AccessToken, nil
+}
+
+// tokenKey identifies the credentials for a repository and the requested access.
+func tokenKey(ref Reference, push bool) string {
+	return fmt.Sprintf("%s/%s:%t", ref.Registry, ref.Repository, push)
+}
+
+// parseChallenge parses a WWW-Authenticate header like
+// Bearer realm="https://auth.example.com/token",service="registry".
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := map[string]string{}
+
+	for rest != "" {
+		var key, value string
+
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, "\"") {
+			value, rest, _ = strings.Cut(rest[1:], "\"")
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		if key != "" {
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+
+	return scheme, params
+}
+
+// nextPage returns the absolute URL of the next page of a paginated response,
+// linked like </v2/pause/tags/list?n=100&last=3.10>; rel="next", or an empty string.
+func nextPage(resp *http.Response) (string, error) {
+	link := resp.Header.Get("Link")
+	if !strings.Contains(link, `rel="next"`) {
+		return "", nil
+	}
+
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end < start {
+		return "", fmt.Errorf("malformed link %q", link)
+	}
+
+	next, err := resp.Request.URL.Parse(link[start+1 : end])
+	if err != nil {
+		return "", err
+	}
+
+	return next.String(), nil
+}
+
+// responseError returns an error describing an unexpected response of the registry.
+func responseError(resp *http.Response, subject string) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	return fmt.Errorf("unexpected response for %s: %s: %s", subject, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// Digest returns the sha256 digest of the content.
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"fmt"
+)
+
+// Copy copies an image, including all images of a manifest list, from one
+// registry to another. The destination keeps the tag of the source unless it
+// has its own.
+func (c *Client) Copy(ctx context.Context, src, dst Reference) error {
+	manifest, err := c.Manifest(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	if dst.Tag == "" && dst.Digest == "" {
+		dst.Tag, dst.Digest = src.Tag, src.Digest
+	}
+
+	if manifest.IsIndex() {
+		for _, desc := range manifest.Manifests {
+			child, err := c.Manifest(ctx, src.WithDigest(desc.Digest))
+			if err != nil {
+				return err
+			}
+
+			if err := c.copyImage(ctx, src, dst.WithDigest(desc.Digest), child); err != nil {
+				return err
+			}
+		}
+
+		return c.putManifest(ctx, dst, manifest)
+	}
+
+	return c.copyImage(ctx, src, dst, manifest)
+}
+
+// copyImage copies the config and layers of an image manifest before the manifest itself.
+func (c *Client) copyImage(ctx context.Context, src, dst Reference, manifest *Manifest) error {
+	blobs := manifest.Layers
+	if manifest.Config != nil {
+		blobs = append([]Descriptor{*manifest.Config}, blobs...)
+	}
+
+	for _, blob := range blobs {
+		// non-distributable layers, e.g. of Windows base images, must not be copied
+		if len(blob.URLs) > 0 {
+			continue
+		}
+
+		if err := c.copyBlob(ctx, src, dst, blob); err != nil {
+			return err
+		}
+	}
+
+	return c.putManifest(ctx, dst, manifest)
+}
+
+// copyBlob copies a blob, unless the destination already contains it.
+func (c *Client) copyBlob(ctx context.Context, src, dst Reference, blob Descriptor) error {
+	exists, err := c.BlobExists(ctx, dst, blob.Digest)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	content, err := c.Blob(ctx, src, blob.Digest)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if err := c.PutBlob(ctx, dst, blob, content); err != nil {
+		return fmt.Errorf("failed to copy blob %s: %w", blob.Digest, err)
+	}
+
+	return nil
+}
+
+// putManifest uploads the manifest, using the tag of the reference if it has one.
+func (c *Client) putManifest(ctx context.Context, ref Reference, manifest *Manifest) error {
+	if ref.Tag != "" {
+		ref.Digest = ""
+	}
+
+	return c.PutManifest(ctx, ref, manifest)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Platforms returns the platforms the referenced image is available for. The
+// platform of an image without a manifest list is read from its configuration.
+func (c *Client) Platforms(ctx context.Context, ref Reference) ([]Platform, error) {
+	manifest, err := c.Manifest(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.IsIndex() {
+		platforms := []Platform{}
+		for _, desc := range manifest.Manifests {
+			if desc.Platform != nil {
+				platforms = append(platforms, *desc.Platform)
+			}
+		}
+
+		return platforms, nil
+	}
+
+	if manifest.Config == nil {
+		return nil, fmt.Errorf("manifest of %s has no config", ref)
+	}
+
+	blob, err := c.Blob(ctx, ref, manifest.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	data, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config of %s: %w", ref, err)
+	}
+
+	platform := Platform{}
+	if err := json.Unmarshal(data, &platform); err != nil {
+		return nil, fmt.Errorf("invalid config of %s: %w", ref, err)
+	}
+
+	return []Platform{platform}, nil
+}
+
+// Supports returns true if one of the platforms is built for the operating system
+// and architecture, regardless of its variant.
+func Supports(platforms []Platform, os, arch string) bool {
+	for _, p := range platforms {
+		if p.OS == os && p.Architecture == arch {
+			return true
+		}
+	}
+
+	return false
+}
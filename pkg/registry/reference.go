@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// dockerHub is the registry of image references without a registry.
+	dockerHub = "docker.io"
+
+	// dockerHubAPI is the host serving the registry API of Docker Hub.
+	dockerHubAPI = "registry-1.docker.io"
+)
+
+// Reference identifies an image in a registry, by tag, digest or both.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ParseReference parses an image reference like registry.k8s.io/pause:3.10.
+// References without a registry refer to Docker Hub, like container runtimes do.
+func ParseReference(image string) (Reference, error) {
+	ref := Reference{}
+
+	name, digest, hasDigest := strings.Cut(image, "@")
+	if hasDigest {
+		if !strings.HasPrefix(digest, "sha256:") {
+			return ref, fmt.Errorf("invalid image reference %q: unsupported digest", image)
+		}
+
+		ref.Digest = digest
+	}
+
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	}
+
+	first, rest, found := strings.Cut(name, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		ref.Registry, ref.Repository = first, rest
+	} else {
+		ref.Registry, ref.Repository = dockerHub, name
+	}
+
+	if ref.Registry == dockerHub && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+
+	if ref.Repository == "" || strings.ToLower(ref.Repository) != ref.Repository {
+		return ref, fmt.Errorf("invalid image reference %q: invalid repository", image)
+	}
+
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+
+	return ref, nil
+}
+
+// String returns the reference in its canonical form.
+func (r Reference) String() string {
+	s := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+
+	return s
+}
+
+// Identifier returns the digest of the reference if known, otherwise its tag.
+func (r Reference) Identifier() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+
+	return r.Tag
+}
+
+// WithDigest returns the reference to the given digest in the same repository.
+func (r Reference) WithDigest(digest string) Reference {
+	return Reference{Registry: r.Registry, Repository: r.Repository, Digest: digest}
+}
+
+// host returns the host serving the registry API.
+func (r Reference) host() string {
+	if r.Registry == dockerHub {
+		return dockerHubAPI
+	}
+
+	return r.Registry
+}
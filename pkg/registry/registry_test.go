@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRegistry is a minimal in-memory implementation of the registry v2 API. If
+// token is set, all requests must carry it as bearer token.
+type fakeRegistry struct {
+	token       string
+	tagsPerPage int
+
+	mu        sync.Mutex
+	manifests map[string]*Manifest
+	blobs     map[string][]byte
+	uploads   int
+}
+
+func newFakeRegistry(t *testing.T, token string) (*fakeRegistry, string) {
+	registry := &fakeRegistry{
+		token:     token,
+		manifests: map[string]*Manifest{},
+		blobs:     map[string][]byte{},
+	}
+
+	server := httptest.NewServer(registry)
+	t.Cleanup(server.Close)
+
+	return registry, strings.TrimPrefix(server.URL, "http://")
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/token" {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": f.token})
+		return
+	}
+
+	if f.token != "" && r.Header.Get("Authorization") != "Bearer "+f.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="http://%s/token",service="fake"`, r.Host))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v2/")
+
+	switch {
+	case strings.HasSuffix(path, "/tags/list"):
+		f.serveTags(w, r, strings.TrimSuffix(path, "/tags/list"))
+
+	case strings.Contains(path, "/manifests/"):
+		repo, ref, _ := strings.Cut(path, "/manifests/")
+		f.serveManifest(w, r, repo, ref)
+
+	case strings.Contains(path, "/blobs/uploads/"):
+		repo, _, _ := strings.Cut(path, "/blobs/uploads/")
+		if r.Method == http.MethodPost {
+			f.uploads++
+			w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%d?state=x", repo, f.uploads))
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		if Digest(data) != r.URL.Query().Get("digest") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f.blobs[repo+"@"+Digest(data)] = data
+		w.WriteHeader(http.StatusCreated)
+
+	case strings.Contains(path, "/blobs/"):
+		repo, digest, _ := strings.Cut(path, "/blobs/")
+		data, ok := f.blobs[repo+"@"+digest]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeRegistry) serveManifest(w http.ResponseWriter, r *http.Request, repo, ref string) {
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		manifest := &Manifest{MediaType: r.Header.Get("Content-Type"), Raw: data, Digest: Digest(data)}
+		f.manifests[repo+":"+ref] = manifest
+		f.manifests[repo+":"+manifest.Digest] = manifest
+		w.WriteHeader(http.StatusCreated)
+
+	default:
+		manifest, ok := f.manifests[repo+":"+ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", manifest.MediaType)
+		w.Header().Set("Docker-Content-Digest", manifest.Digest)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(manifest.Raw)
+		}
+	}
+}
+
+// serveTags lists the tags of a repository, tagsPerPage at a time if set.
+func (f *fakeRegistry) serveTags(w http.ResponseWriter, r *http.Request, repo string) {
+	tags := []string{}
+	for key := range f.manifests {
+		if name, tag, _ := strings.Cut(key, ":"); name == repo && !strings.HasPrefix(tag, "sha256:") && tag > r.URL.Query().Get("last") {
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(tags) == 0 && r.URL.Query().Get("last") == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	slices.Sort(tags)
+
+	if n := f.tagsPerPage; n > 0 && len(tags) > n {
+		tags = tags[:n]
+		w.Header().Set("Link", fmt.Sprintf(`</v2/%s/tags/list?n=%d&last=%s>; rel="next"`, repo, n, tags[n-1]))
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"name": repo, "tags": tags})
+}
+
+// addBlob stores a blob and returns its descriptor.
+func (f *fakeRegistry) addBlob(repo, mediaType string, data []byte) Descriptor {
+	f.blobs[repo+"@"+Digest(data)] = data
+	return Descriptor{MediaType: mediaType, Digest: Digest(data), Size: int64(len(data))}
+}
+
+// addManifest stores a manifest under its digest and the given tags and returns its descriptor.
+func (f *fakeRegistry) addManifest(t *testing.T, repo string, manifest any, mediaType string, tags ...string) Descriptor {
+	data, err := json.Marshal(manifest)
+	require.NoError(t, err)
+
+	stored := &Manifest{MediaType: mediaType, Raw: data, Digest: Digest(data)}
+	for _, ref := range append(tags, stored.Digest) {
+		f.manifests[repo+":"+ref] = stored
+	}
+
+	return Descriptor{MediaType: mediaType, Digest: stored.Digest, Size: int64(len(data))}
+}
+
+// addMultiArchImage stores an image for amd64 and arm64 and returns the digest of its index.
+func (f *fakeRegistry) addMultiArchImage(t *testing.T, repo, tag string) string {
+	index := Manifest{MediaType: MediaTypeOCIIndex}
+
+	for _, arch := range []string{"amd64", "arm64"} {
+		config := f.addBlob(repo, "application/vnd.oci.image.config.v1+json", []byte(`{"architecture":"`+arch+`"}`))
+		layer := f.addBlob(repo, "application/vnd.oci.image.layer.v1.tar+gzip", []byte("layer-"+arch))
+
+		desc := f.addManifest(t, repo, Manifest{MediaType: MediaTypeOCIManifest, Config: &config, Layers: []Descriptor{layer}}, MediaTypeOCIManifest)
+		desc.Platform = &Platform{OS: "linux", Architecture: arch}
+		index.Manifests = append(index.Manifests, desc)
+	}
+
+	return f.addManifest(t, repo, index, MediaTypeOCIIndex, tag).Digest
+}
+
+func TestParseReference(t *testing.T) {
+	testCases := []struct {
+		image    string
+		expected Reference
+	}{
+		{
+			image:    "registry.k8s.io/e2e-test-images/agnhost:2.53",
+			expected: Reference{Registry: "registry.k8s.io", Repository: "e2e-test-images/agnhost", Tag: "2.53"},
+		},
+		{
+			image:    "localhost:5000/pause",
+			expected: Reference{Registry: "localhost:5000", Repository: "pause", Tag: "latest"},
+		},
+		{
+			image:    "nginx:1.27@sha256:0123",
+			expected: Reference{Registry: "docker.io", Repository: "library/nginx", Tag: "1.27", Digest: "sha256:0123"},
+		},
+		{
+			image:    "docker.io/library/httpd:2.4",
+			expected: Reference{Registry: "docker.io", Repository: "library/httpd", Tag: "2.4"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.image, func(t *testing.T) {
+			ref, err := ParseReference(tc.image)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+		})
+	}
+
+	_, err := ParseReference("registry.k8s.io/Pause:3.10")
+	assert.Error(t, err)
+
+	_, err = ParseReference("registry.k8s.io/pause@md5:0123")
+	assert.Error(t, err)
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:pause:pull"`)
+
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:pause:pull",
+	}, params)
+}
+
+func TestCopy(t *testing.T) {
+	source, sourceHost := newFakeRegistry(t, "secret")
+	target, targetHost := newFakeRegistry(t, "")
+
+	digest := source.addMultiArchImage(t, "e2e-test-images/agnhost", "2.53")
+
+	src, err := ParseReference(sourceHost + "/e2e-test-images/agnhost:2.53")
+	require.NoError(t, err)
+
+	dst, err := ParseReference(targetHost + "/mirror/e2e-test-images/agnhost")
+	require.NoError(t, err)
+
+	client := NewClient(nil)
+	require.NoError(t, client.Copy(context.Background(), src, Reference{Registry: dst.Registry, Repository: dst.Repository}))
+
+	copied, err := client.Manifest(context.Background(), Reference{Registry: targetHost, Repository: "mirror/e2e-test-images/agnhost", Tag: "2.53"})
+	require.NoError(t, err)
+	assert.Equal(t, digest, copied.Digest)
+	assert.True(t, copied.IsIndex())
+	assert.Len(t, copied.Manifests, 2)
+
+	for _, desc := range copied.Manifests {
+		image, err := client.Manifest(context.Background(), dst.WithDigest(desc.Digest))
+		require.NoError(t, err)
+
+		for _, blob := range append(image.Layers, *image.Config) {
+			exists, err := client.BlobExists(context.Background(), dst, blob.Digest)
+			require.NoError(t, err)
+			assert.True(t, exists, blob.Digest)
+		}
+	}
+
+	// a second copy only checks for existing blobs
+	uploads := target.uploads
+	require.NoError(t, client.Copy(context.Background(), src, dst.WithDigest(digest)))
+	assert.Equal(t, uploads, target.uploads)
+
+	_, err = client.Manifest(context.Background(), Reference{Registry: targetHost, Repository: "missing", Tag: "1.0"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestPlatforms(t *testing.T) {
+	source, host := newFakeRegistry(t, "")
+
+	source.addMultiArchImage(t, "e2e-test-images/agnhost", "2.53")
+
+	config := source.addBlob("pause", "application/vnd.oci.image.config.v1+json", []byte(`{"architecture":"amd64","os":"linux"}`))
+	source.addManifest(t, "pause", Manifest{MediaType: MediaTypeOCIManifest, Config: &config}, MediaTypeOCIManifest, "3.10")
+
+	client := NewClient(nil)
+
+	platforms, err := client.Platforms(context.Background(), Reference{Registry: host, Repository: "e2e-test-images/agnhost", Tag: "2.53"})
+	require.NoError(t, err)
+	assert.Equal(t, []Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}, platforms)
+	assert.True(t, Supports(platforms, "linux", "arm64"))
+	assert.False(t, Supports(platforms, "linux", "s390x"))
+
+	platforms, err = client.Platforms(context.Background(), Reference{Registry: host, Repository: "pause", Tag: "3.10"})
+	require.NoError(t, err)
+	assert.Equal(t, []Platform{{OS: "linux", Architecture: "amd64"}}, platforms)
+	assert.False(t, Supports(platforms, "windows", "amd64"))
+
+	_, err = client.Platforms(context.Background(), Reference{Registry: host, Repository: "pause", Tag: "missing"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestTags(t *testing.T) {
+	source, host := newFakeRegistry(t, "secret")
+	source.tagsPerPage = 2
+
+	for _, tag := range []string{"v1.34.2", "v1.35.0", "v1.35.1"} {
+		source.addManifest(t, "conformance", Manifest{MediaType: MediaTypeOCIManifest}, MediaTypeOCIManifest, tag)
+	}
+
+	client := NewClient(nil)
+
+	tags, err := client.Tags(context.Background(), Reference{Registry: host, Repository: "conformance"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.34.2", "v1.35.0", "v1.35.1"}, tags)
+
+	_, err = client.Tags(context.Background(), Reference{Registry: host, Repository: "missing"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/results"
+)
+
+const (
+	// LogFile is the name of the e2e log in the output directory.
+	LogFile = "e2e.log"
+
+	// otherSIG groups all specs whose name does not contain a SIG tag.
+	otherSIG = "other"
+
+	// maxExcerptLines limits the length of the log excerpt shown for a failure.
+	maxExcerptLines = 200
+
+	// specSeparator is the line Ginkgo prints between the output of two specs.
+	specSeparator = "------------------------------"
+)
+
+var (
+	//go:embed report.html.tmpl
+	htmlTemplate string
+
+	// sigTag matches the tag of the owning SIG in a spec name, e.g. [sig-node].
+	sigTag = regexp.MustCompile(`\[(sig-[\w-]+)\]`)
+)
+
+// Report is everything needed to render the report of a test run.
+type Report struct {
+	// Run is the metadata of the run, or nil if no run summary was found.
+	Run     *results.Run
+	Summary *results.Summary
+	// Verdict is the outcome of the run after applying its known failures.
+	Verdict *results.Verdict
+	// SIGs is the breakdown of the results by owning SIG, sorted by name.
+	SIGs     []SIG
+	Failures []Failure
+}
+
+// SIG is the number of specs per result of a single SIG.
+type SIG struct {
+	Name    string
+	Passed  int
+	Failed  int
+	Skipped int
+}
+
+// Failure is a failed spec together with its output from the e2e log.
+type Failure struct {
+	results.TestResult
+	// Log is the excerpt of the e2e log for this spec, if it could be found.
+	Log string
+}
+
+// Load builds the report from the results in the given output directory. Only the
+// JUnit report is required; the run summary and the e2e log are used if present.
+func Load(dir string) (*Report, error) {
+	summary, err := results.Load(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load results: %w", err)
+	}
+
+	run, err := results.LoadRunFile(filepath.Join(dir, results.SummaryFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load run summary: %w", err)
+	}
+
+	e2eLog, err := os.ReadFile(filepath.Join(dir, LogFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load e2e log: %w", err)
+	}
+
+	return New(summary, run, string(e2eLog)), nil
+}
+
+// New builds the report from the summary of a run, its metadata and its e2e log.
+func New(summary *results.Summary, run *results.Run, e2eLog string) *Report {
+	report := &Report{
+		Run:      run,
+		Summary:  summary,
+		Verdict:  verdict(summary, run),
+		SIGs:     sigBreakdown(summary.Tests),
+		Failures: []Failure{},
+	}
+
+	blocks := strings.Split(e2eLog, specSeparator)
+
+	for _, result := range summary.Failures {
+		report.Failures = append(report.Failures, Failure{
+			TestResult: result,
+			Log:        logExcerpt(blocks, result.Name),
+		})
+	}
+
+	return report
+}
+
+// verdict returns the verdict recorded for the run, or evaluates the known failures
+// of its configuration if none was recorded. Without a run summary, every failure
+// is unexpected.
+func verdict(summary *results.Summary, run *results.Run) *results.Verdict {
+	if run == nil {
+		return results.Evaluate(summary, nil, time.Now())
+	}
+
+	if run.Verdict != nil {
+		return run.Verdict
+	}
+
+	return results.Evaluate(summary, run.Configuration.KnownFailures, run.EndTime)
+}
+
+// Passed returns true if the run has no unexpected failures and, if its summary
+// is known, exited successfully.
+func (r *Report) Passed() bool {
+	return len(r.Verdict.Unexpected) == 0 && (r.Run == nil || r.Run.ExitCode == 0)
+}
+
+// RenderHTML writes the report as a single, self-contained HTML page.
+func (r *Report) RenderHTML(w io.Writer) error {
+	tmpl, err := template.New("report").Funcs(template.FuncMap{
+		"seconds": func(s float64) time.Duration {
+			return time.Duration(s * float64(time.Second)).Round(time.Millisecond)
+		},
+		"round": func(d time.Duration) time.Duration {
+			return d.Round(time.Second)
+		},
+		"add": func(a, b int) int {
+			return a + b
+		},
+		"percent": func(part, total int) string {
+			if total == 0 {
+				return "0"
+			}
+
+			return fmt.Sprintf("%.1f", float64(part)*100/float64(total))
+		},
+	}).Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("invalid report template: %w", err)
+	}
+
+	return tmpl.Execute(w, r)
+}
+
+// sigOf returns the SIG owning the spec with the given name.
+func sigOf(name string) string {
+	if match := sigTag.FindStringSubmatch(name); match != nil {
+		return match[1]
+	}
+
+	return otherSIG
+}
+
+// sigBreakdown counts the results of the given specs per SIG.
+func sigBreakdown(tests []results.TestResult) []SIG {
+	index := map[string]int{}
+	sigs := []SIG{}
+
+	for _, test := range tests {
+		name := sigOf(test.Name)
+
+		i, exists := index[name]
+		if !exists {
+			i = len(sigs)
+			index[name] = i
+			sigs = append(sigs, SIG{Name: name})
+		}
+
+		switch test.Status {
+		case junit.StatusPassed:
+			sigs[i].Passed++
+		case junit.StatusFailed:
+			sigs[i].Failed++
+		default:
+			sigs[i].Skipped++
+		}
+	}
+
+	slices.SortFunc(sigs, func(a, b SIG) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return sigs
+}
+
+// logExcerpt returns the output of the given failed spec from the blocks of the e2e
+// log. Ginkgo prints the name of a failed spec with its node type, e.g.
+// "[sig-node] Pods [It] should be evicted", so the node type is ignored when matching.
+func logExcerpt(blocks []string, name string) string {
+	for _, block := range blocks {
+		if !strings.Contains(block, "[FAILED]") && !strings.Contains(block, "[TIMEDOUT]") &&
+			!strings.Contains(block, "[PANICKED]") && !strings.Contains(block, "[INTERRUPTED]") {
+			continue
+		}
+
+		for _, line := range strings.Split(block, "\n") {
+			if strings.Contains(strings.ReplaceAll(line, "[It] ", ""), name) {
+				return tail(strings.Trim(block, "\n"), maxExcerptLines)
+			}
+		}
+	}
+
+	return ""
+}
+
+// tail returns the last n lines of s.
+func tail(s string, n int) string {
+	lines := strings.Split(s, "\n")
+	if len(lines) <= n {
+		return s
+	}
+
+	return strings.Join(lines[len(lines)-n:], "\n")
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const e2eLog = `Running Suite: Kubernetes e2e suite
+------------------------------
+• [0.512 seconds]
+[sig-apps] Deployment [It] should work [Conformance]
+k8s.io/kubernetes/test/e2e/apps/deployment.go:42
+------------------------------
+• [FAILED] [100.001 seconds]
+[sig-node] Pods [It] should be evicted <script> [Serial] [Conformance]
+k8s.io/kubernetes/test/e2e/node/pods.go:100
+
+  Timeline >>
+  STEP: creating the pod
+  << Timeline
+
+  [FAILED] timed out waiting for the condition
+  In [It] at: k8s.io/kubernetes/test/e2e/node/pods.go:123
+------------------------------
+`
+
+func testSummary() *results.Summary {
+	failed := results.TestResult{
+		Name:     "[sig-node] Pods should be evicted <script> [Serial] [Conformance]",
+		Status:   junit.StatusFailed,
+		Duration: 100,
+		Message:  "timed out waiting for the condition",
+		Location: "k8s.io/kubernetes/test/e2e/node/pods.go:123",
+	}
+
+	return &results.Summary{
+		Total:    4,
+		Passed:   1,
+		Failed:   1,
+		Skipped:  2,
+		Duration: 2 * time.Minute,
+		Tests: []results.TestResult{
+			{Name: "[sig-apps] Deployment should work [Conformance]", Status: junit.StatusPassed, Duration: 0.5},
+			failed,
+			{Name: "[sig-apps] StatefulSet should scale", Status: junit.StatusSkipped},
+			{Name: "Kubectl client should work", Status: junit.StatusSkipped},
+		},
+		Failures: []results.TestResult{failed},
+	}
+}
+
+func TestNew(t *testing.T) {
+	report := New(testSummary(), nil, e2eLog)
+
+	assert.Equal(t, []SIG{
+		{Name: "other", Skipped: 1},
+		{Name: "sig-apps", Passed: 1, Skipped: 1},
+		{Name: "sig-node", Failed: 1},
+	}, report.SIGs)
+
+	require.Len(t, report.Failures, 1)
+	assert.Contains(t, report.Failures[0].Log, "STEP: creating the pod")
+	assert.NotContains(t, report.Failures[0].Log, "Deployment")
+}
+
+func TestNewWithoutLog(t *testing.T) {
+	report := New(testSummary(), nil, "")
+
+	require.Len(t, report.Failures, 1)
+	assert.Empty(t, report.Failures[0].Log)
+}
+
+func TestRenderHTML(t *testing.T) {
+	run := &results.Run{
+		Configuration:    types.NewDefaultConfiguration(),
+		ServerVersion:    &version.Info{GitVersion: "v1.33.1"},
+		ConformanceImage: "registry.k8s.io/conformance:v1.33.1",
+		StartTime:        time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:          time.Date(2026, 1, 1, 10, 2, 0, 0, time.UTC),
+		ExitCode:         1,
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, New(testSummary(), run, e2eLog).RenderHTML(buf))
+
+	html := buf.String()
+	assert.Contains(t, html, "FAILED")
+	assert.Contains(t, html, "v1.33.1")
+	assert.Contains(t, html, "registry.k8s.io/conformance:v1.33.1")
+	assert.Contains(t, html, "sig-node")
+	assert.Contains(t, html, "STEP: creating the pod")
+	assert.Contains(t, html, "should be evicted &lt;script&gt;")
+	assert.NotContains(t, html, "<script>")
+}
+
+func TestRenderHTMLVerdict(t *testing.T) {
+	summary := testSummary()
+	knownFailure := types.KnownFailure{Spec: "should be evicted", Reason: "no eviction API"}
+
+	tests := []struct {
+		name     string
+		summary  *results.Summary
+		run      *results.Run
+		expected string
+	}{
+		{
+			name:     "failures without run summary",
+			summary:  summary,
+			expected: "FAILED",
+		},
+		{
+			name:     "known failures only",
+			summary:  summary,
+			run:      &results.Run{Verdict: results.Evaluate(summary, []types.KnownFailure{knownFailure}, time.Now())},
+			expected: "PASSED",
+		},
+		{
+			name:    "known failures from the configuration",
+			summary: summary,
+			run: &results.Run{Configuration: types.Configuration{
+				KnownFailures: []types.KnownFailure{knownFailure},
+			}},
+			expected: "PASSED",
+		},
+		{
+			name:     "failed suite setup",
+			summary:  &results.Summary{SuiteFailures: []results.TestResult{{Name: "[SynchronizedBeforeSuite]", Status: junit.StatusFailed}}},
+			expected: "FAILED",
+		},
+		{
+			name:     "non-zero exit code",
+			summary:  &results.Summary{},
+			run:      &results.Run{ExitCode: 1},
+			expected: "FAILED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, New(tt.summary, tt.run, "").RenderHTML(buf))
+			assert.Contains(t, buf.String(), `<span class="verdict `+strings.ToLower(tt.expected)+`">`+tt.expected+`</span>`)
+		})
+	}
+}
+
+func TestLogExcerptTail(t *testing.T) {
+	assert.Equal(t, "c\nd", tail("a\nb\nc\nd", 2))
+	assert.Equal(t, "a\nb", tail("a\nb", 5))
+}
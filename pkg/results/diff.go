@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"math"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/log"
+)
+
+// minDurationChange is the smallest absolute change in duration that is considered
+// significant, so that fast specs do not show up because of noise.
+const minDurationChange = 10 * time.Second
+
+// Diff is the difference between the results of two test runs.
+type Diff struct {
+	// Compared is the number of specs present in both runs.
+	Compared int `json:"compared"`
+	// NewlyFailing are specs that failed in the new run, but not in the old one.
+	NewlyFailing []TestResult `json:"newlyFailing"`
+	// NewlyPassing are specs that passed in the new run, but failed in the old one.
+	NewlyPassing []TestResult `json:"newlyPassing"`
+	// Added are specs that only exist in the new run.
+	Added []TestResult `json:"added"`
+	// Removed are specs that only exist in the old run.
+	Removed []TestResult `json:"removed"`
+	// DurationChanges are specs executed in both runs whose duration changed significantly.
+	DurationChanges []DurationChange `json:"durationChanges"`
+}
+
+// DurationChange describes how the duration of a spec changed between two runs.
+type DurationChange struct {
+	Name string `json:"name"`
+	// Old and New are the durations of the spec in seconds.
+	Old float64 `json:"old"`
+	New float64 `json:"new"`
+}
+
+// Compare matches the specs of two runs by name and reports how their results
+// changed. A change in duration is significant if it is larger than the given
+// threshold, relative to the old duration.
+func Compare(oldSummary, newSummary *Summary, threshold float64) *Diff {
+	diff := &Diff{
+		NewlyFailing:    []TestResult{},
+		NewlyPassing:    []TestResult{},
+		Added:           []TestResult{},
+		Removed:         []TestResult{},
+		DurationChanges: []DurationChange{},
+	}
+
+	oldTests := map[string]TestResult{}
+	for _, test := range oldSummary.Tests {
+		oldTests[test.Name] = test
+	}
+
+	newTests := map[string]TestResult{}
+	for _, test := range newSummary.Tests {
+		newTests[test.Name] = test
+	}
+
+	for _, test := range newSummary.Tests {
+		old, exists := oldTests[test.Name]
+		if !exists {
+			diff.Added = append(diff.Added, test)
+			continue
+		}
+
+		diff.Compared++
+
+		switch {
+		case test.Status == junit.StatusFailed && old.Status != junit.StatusFailed:
+			diff.NewlyFailing = append(diff.NewlyFailing, test)
+		case test.Status == junit.StatusPassed && old.Status == junit.StatusFailed:
+			diff.NewlyPassing = append(diff.NewlyPassing, test)
+		}
+
+		if test.Status != junit.StatusSkipped && old.Status != junit.StatusSkipped &&
+			significantChange(old.Duration, test.Duration, threshold) {
+			diff.DurationChanges = append(diff.DurationChanges, DurationChange{
+				Name: test.Name,
+				Old:  old.Duration,
+				New:  test.Duration,
+			})
+		}
+	}
+
+	for _, test := range oldSummary.Tests {
+		if _, exists := newTests[test.Name]; !exists {
+			diff.Removed = append(diff.Removed, test)
+		}
+	}
+
+	return diff
+}
+
+// Regressions returns the specs that fail in the new run, but did not fail in the
+// old one, including newly added specs.
+func (d *Diff) Regressions() []TestResult {
+	regressions := append([]TestResult{}, d.NewlyFailing...)
+
+	for _, test := range d.Added {
+		if test.Status == junit.StatusFailed {
+			regressions = append(regressions, test)
+		}
+	}
+
+	return regressions
+}
+
+// Print logs the diff in a human-readable form.
+func (d *Diff) Print() {
+	log.Printf("Compared %d specs: %d newly failing, %d newly passing, %d added, %d removed, %d with significant duration changes.",
+		d.Compared, len(d.NewlyFailing), len(d.NewlyPassing), len(d.Added), len(d.Removed), len(d.DurationChanges))
+
+	if len(d.NewlyFailing) > 0 {
+		log.Errorf("Newly failing tests:")
+		for _, test := range d.NewlyFailing {
+			log.Errorf("  %s", test.Name)
+		}
+	}
+
+	if len(d.NewlyPassing) > 0 {
+		log.Println("Newly passing tests:")
+		for _, test := range d.NewlyPassing {
+			log.Printf("  %s", test.Name)
+		}
+	}
+
+	if len(d.Added) > 0 {
+		log.Println("Added tests:")
+		for _, test := range d.Added {
+			log.Printf("  [%s] %s", test.Status, test.Name)
+		}
+	}
+
+	if len(d.Removed) > 0 {
+		log.Println("Removed tests:")
+		for _, test := range d.Removed {
+			log.Printf("  [%s] %s", test.Status, test.Name)
+		}
+	}
+
+	if len(d.DurationChanges) > 0 {
+		log.Println("Significant duration changes:")
+		for _, change := range d.DurationChanges {
+			log.Printf("  %8v -> %8v  %s", seconds(change.Old).Round(time.Second), seconds(change.New).Round(time.Second), change.Name)
+		}
+	}
+}
+
+// significantChange returns true if the duration changed by more than the relative
+// threshold and by at least minDurationChange.
+func significantChange(oldDuration, newDuration, threshold float64) bool {
+	delta := math.Abs(newDuration - oldDuration)
+	if seconds(delta) < minDurationChange {
+		return false
+	}
+
+	return delta > oldDuration*threshold
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	oldSummary := &Summary{Tests: []TestResult{
+		{Name: "stable", Status: junit.StatusPassed, Duration: 10},
+		{Name: "regressed", Status: junit.StatusPassed, Duration: 10},
+		{Name: "fixed", Status: junit.StatusFailed, Duration: 10},
+		{Name: "slower", Status: junit.StatusPassed, Duration: 30},
+		{Name: "slightly slower", Status: junit.StatusPassed, Duration: 2},
+		{Name: "now running", Status: junit.StatusSkipped},
+		{Name: "removed", Status: junit.StatusPassed, Duration: 1},
+	}}
+
+	newSummary := &Summary{Tests: []TestResult{
+		{Name: "stable", Status: junit.StatusPassed, Duration: 11},
+		{Name: "regressed", Status: junit.StatusFailed, Duration: 12},
+		{Name: "fixed", Status: junit.StatusPassed, Duration: 10},
+		{Name: "slower", Status: junit.StatusPassed, Duration: 90},
+		{Name: "slightly slower", Status: junit.StatusPassed, Duration: 8},
+		{Name: "now running", Status: junit.StatusFailed, Duration: 5},
+		{Name: "added", Status: junit.StatusFailed, Duration: 1},
+	}}
+
+	diff := Compare(oldSummary, newSummary, 0.5)
+
+	assert.Equal(t, 6, diff.Compared)
+	assert.Equal(t, []TestResult{newSummary.Tests[1], newSummary.Tests[5]}, diff.NewlyFailing)
+	assert.Equal(t, []TestResult{newSummary.Tests[2]}, diff.NewlyPassing)
+	assert.Equal(t, []TestResult{newSummary.Tests[6]}, diff.Added)
+	assert.Equal(t, []TestResult{oldSummary.Tests[6]}, diff.Removed)
+	assert.Equal(t, []DurationChange{{Name: "slower", Old: 30, New: 90}}, diff.DurationChanges)
+	assert.Equal(t, []TestResult{newSummary.Tests[1], newSummary.Tests[5], newSummary.Tests[6]}, diff.Regressions())
+}
+
+func TestCompareIdentical(t *testing.T) {
+	summary := &Summary{Tests: []TestResult{
+		{Name: "a", Status: junit.StatusFailed, Duration: 10},
+		{Name: "b", Status: junit.StatusPassed, Duration: 10},
+	}}
+
+	diff := Compare(summary, summary, 0.5)
+
+	assert.Equal(t, 2, diff.Compared)
+	assert.Empty(t, diff.NewlyFailing)
+	assert.Empty(t, diff.NewlyPassing)
+	assert.Empty(t, diff.DurationChanges)
+	assert.Empty(t, diff.Regressions())
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/log"
+	"sigs.k8s.io/hydrophone/pkg/types"
+)
+
+// Verdict is the outcome of a run after applying the known failures.
+type Verdict struct {
+	// Unexpected are failed specs that are not covered by a valid known failure,
+	// and failed suite-level nodes.
+	Unexpected []TestResult `json:"unexpected"`
+	// Expected are failed specs covered by a known failure.
+	Expected []KnownResult `json:"expected"`
+	// UnexpectedlyPassed are specs covered by a known failure that passed.
+	UnexpectedlyPassed []KnownResult `json:"unexpectedlyPassed"`
+	// Expired are the known failures whose expiry date has passed. Failures
+	// covered only by expired entries are unexpected.
+	Expired []types.KnownFailure `json:"expired"`
+}
+
+// KnownResult is the result of a spec covered by a known failure.
+type KnownResult struct {
+	TestResult
+	KnownFailure types.KnownFailure `json:"knownFailure"`
+}
+
+// Evaluate applies the known failures to the results of a run.
+func Evaluate(summary *Summary, knownFailures []types.KnownFailure, now time.Time) *Verdict {
+	verdict := &Verdict{
+		Unexpected:         []TestResult{},
+		Expected:           []KnownResult{},
+		UnexpectedlyPassed: []KnownResult{},
+		Expired:            []types.KnownFailure{},
+	}
+
+	// a failure of the suite setup or teardown cannot be a known failure
+	verdict.Unexpected = append(verdict.Unexpected, summary.SuiteFailures...)
+
+	valid := []types.KnownFailure{}
+
+	for _, known := range knownFailures {
+		if known.Expired(now) {
+			verdict.Expired = append(verdict.Expired, known)
+		} else {
+			valid = append(valid, known)
+		}
+	}
+
+	for _, test := range summary.Tests {
+		known, found := match(valid, test.Name)
+
+		switch {
+		case test.Status == junit.StatusFailed && found:
+			verdict.Expected = append(verdict.Expected, KnownResult{TestResult: test, KnownFailure: known})
+		case test.Status == junit.StatusFailed:
+			verdict.Unexpected = append(verdict.Unexpected, test)
+		case test.Status == junit.StatusPassed && found:
+			verdict.UnexpectedlyPassed = append(verdict.UnexpectedlyPassed, KnownResult{TestResult: test, KnownFailure: known})
+		}
+	}
+
+	return verdict
+}
+
+// ExitCode determines the exit code of a run from the exit code of the test suite.
+// Failed specs are only fatal if they are unexpected, but a failure of the test
+// suite that is not caused by any spec, e.g. in its setup, is preserved.
+func (v *Verdict) ExitCode(testExitCode int) int {
+	switch {
+	case len(v.Unexpected) > 0:
+		return max(testExitCode, 1)
+	case len(v.Expected) > 0:
+		return 0
+	default:
+		return testExitCode
+	}
+}
+
+// Print logs the known failures that were applied and everything that needs attention.
+func (v *Verdict) Print() {
+	for _, result := range v.Expected {
+		log.Warnf("Ignoring known failure of %s: %s", result.Name, result.KnownFailure.Reason)
+	}
+
+	for _, result := range v.UnexpectedlyPassed {
+		log.Warnf("Known failure passed unexpectedly, consider removing it: %s (%s)", result.Name, result.KnownFailure.Spec)
+	}
+
+	for _, known := range v.Expired {
+		log.Warnf("Known failure expired on %s and is no longer applied: %s (%s)", known.Expires, known.Spec, known.Reason)
+	}
+
+	if len(v.Expected) > 0 && len(v.Unexpected) == 0 {
+		log.Printf("All %d failure(s) are known.", len(v.Expected))
+	}
+
+	if len(v.Unexpected) > 0 && len(v.Expected) > 0 {
+		log.Errorf("%d unexpected failure(s), %d known failure(s).", len(v.Unexpected), len(v.Expected))
+	}
+}
+
+// match returns the first known failure matching the given spec name.
+func match(knownFailures []types.KnownFailure, name string) (types.KnownFailure, bool) {
+	for _, known := range knownFailures {
+		if known.Matches(name) {
+			return known, true
+		}
+	}
+
+	return types.KnownFailure{}, false
+}
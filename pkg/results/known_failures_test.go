@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"testing"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	loadBalancer := types.KnownFailure{Spec: `LoadBalancer`, Reason: "no load balancer"}
+	expired := types.KnownFailure{Spec: `NodePort`, Reason: "firewall", Expires: "2026-06-30"}
+	fixed := types.KnownFailure{Spec: `DNS`, Reason: "flaky DNS"}
+
+	summary := &Summary{Tests: []TestResult{
+		{Name: "[sig-network] Services should create a LoadBalancer", Status: junit.StatusFailed},
+		{Name: "[sig-network] Services should serve a NodePort", Status: junit.StatusFailed},
+		{Name: "[sig-network] DNS should resolve", Status: junit.StatusPassed},
+		{Name: "[sig-node] Pods should run", Status: junit.StatusPassed},
+		{Name: "[sig-network] LoadBalancer should be skipped", Status: junit.StatusSkipped},
+	}}
+
+	verdict := Evaluate(summary, []types.KnownFailure{loadBalancer, expired, fixed}, now)
+
+	assert.Equal(t, []TestResult{summary.Tests[1]}, verdict.Unexpected)
+	assert.Equal(t, []KnownResult{{TestResult: summary.Tests[0], KnownFailure: loadBalancer}}, verdict.Expected)
+	assert.Equal(t, []KnownResult{{TestResult: summary.Tests[2], KnownFailure: fixed}}, verdict.UnexpectedlyPassed)
+	assert.Equal(t, []types.KnownFailure{expired}, verdict.Expired)
+}
+
+func TestVerdictExitCode(t *testing.T) {
+	failed := TestResult{Name: "foo", Status: junit.StatusFailed}
+
+	testCases := []struct {
+		name         string
+		verdict      Verdict
+		testExitCode int
+		expected     int
+	}{
+		{
+			name:     "success",
+			expected: 0,
+		},
+		{
+			name:         "unexpected failure",
+			verdict:      Verdict{Unexpected: []TestResult{failed}},
+			testExitCode: 1,
+			expected:     1,
+		},
+		{
+			name:         "only known failures",
+			verdict:      Verdict{Expected: []KnownResult{{TestResult: failed}}},
+			testExitCode: 1,
+			expected:     0,
+		},
+		{
+			name:         "unexpected and known failures",
+			verdict:      Verdict{Unexpected: []TestResult{failed}, Expected: []KnownResult{{TestResult: failed}}},
+			testExitCode: 1,
+			expected:     1,
+		},
+		{
+			name:         "suite failed without failed specs",
+			testExitCode: 2,
+			expected:     2,
+		},
+		{
+			name:         "failed spec with successful suite",
+			verdict:      Verdict{Unexpected: []TestResult{failed}},
+			testExitCode: 0,
+			expected:     1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.verdict.ExitCode(tc.testExitCode))
+		})
+	}
+}
+
+func TestEvaluateSuiteFailure(t *testing.T) {
+	loadBalancer := types.KnownFailure{Spec: `LoadBalancer`, Reason: "no load balancer"}
+	beforeSuite := TestResult{Name: "[SynchronizedBeforeSuite]", Status: junit.StatusFailed, Message: "nodes are not ready"}
+
+	summary := &Summary{
+		SuiteFailures: []TestResult{beforeSuite},
+		Tests: []TestResult{
+			{Name: "[sig-network] Services should create a LoadBalancer", Status: junit.StatusFailed},
+		},
+	}
+
+	verdict := Evaluate(summary, []types.KnownFailure{loadBalancer}, time.Now())
+
+	assert.Equal(t, []TestResult{beforeSuite}, verdict.Unexpected)
+	assert.Len(t, verdict.Expected, 1)
+	assert.Equal(t, 1, verdict.ExitCode(1))
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"cmp"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/log"
+)
+
+// slowestSpecs is the number of slowest specs included in a summary.
+const slowestSpecs = 10
+
+// Summary is the outcome of a test run, as recorded in its JUnit report.
+type Summary struct {
+	Total    int           `json:"total"`
+	Passed   int           `json:"passed"`
+	Failed   int           `json:"failed"`
+	Skipped  int           `json:"skipped"`
+	Duration time.Duration `json:"-"`
+	// Tests lists the results of all specs in the order they appear in the report.
+	Tests []TestResult `json:"tests"`
+	// SuiteFailures lists the failed suite-level nodes, e.g. [SynchronizedBeforeSuite],
+	// which are not specs and are therefore never covered by known failures.
+	SuiteFailures []TestResult `json:"suiteFailures,omitempty"`
+	// Failures lists all failed specs in the order they appear in the report.
+	Failures []TestResult `json:"-"`
+	// Slowest lists the executed specs that took the longest, slowest first.
+	Slowest []TestResult `json:"-"`
+}
+
+// TestResult is the result of a single spec.
+type TestResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Duration is the time the spec took, in seconds.
+	Duration float64 `json:"duration"`
+	// Message is the failure message reported by a failed spec.
+	Message string `json:"message,omitempty"`
+	// Location is the source location of the failed assertion, if known.
+	Location string `json:"location,omitempty"`
+}
+
+// Load reads the JUnit report from the given results directory and summarizes it.
+func Load(dir string) (*Summary, error) {
+	return LoadFile(filepath.Join(dir, junit.ReportFile))
+}
+
+// LoadFile reads the given JUnit report and summarizes it.
+func LoadFile(filename string) (*Summary, error) {
+	report, err := junit.LoadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return Summarize(report), nil
+}
+
+// Summarize computes the summary of a JUnit report. Suite-level setup and
+// teardown nodes are not counted as specs.
+func Summarize(report *junit.TestSuites) *Summary {
+	summary := &Summary{
+		Duration: seconds(report.Time),
+		Tests:    []TestResult{},
+	}
+
+	executed := []TestResult{}
+
+	for _, suite := range report.TestSuites {
+		if report.Time == 0 {
+			summary.Duration += seconds(suite.Time)
+		}
+
+		for _, tc := range suite.TestCases {
+			if !tc.IsSpec() {
+				if tc.Failed() {
+					result := TestResult{Name: tc.Name, Status: junit.StatusFailed, Duration: tc.Time}
+					result.Message, result.Location = failure(&tc)
+					summary.SuiteFailures = append(summary.SuiteFailures, result)
+				}
+
+				continue
+			}
+
+			result := TestResult{
+				Name:     tc.SpecName(),
+				Status:   junit.StatusPassed,
+				Duration: tc.Time,
+			}
+
+			switch {
+			case tc.IsSkipped():
+				result.Status = junit.StatusSkipped
+				summary.Skipped++
+
+			case tc.Failed():
+				result.Status = junit.StatusFailed
+				result.Message, result.Location = failure(&tc)
+				summary.Failed++
+				summary.Failures = append(summary.Failures, result)
+
+			default:
+				summary.Passed++
+			}
+
+			summary.Total++
+			summary.Tests = append(summary.Tests, result)
+
+			if result.Status != junit.StatusSkipped {
+				executed = append(executed, result)
+			}
+		}
+	}
+
+	slices.SortStableFunc(executed, func(a, b TestResult) int {
+		return cmp.Compare(b.Duration, a.Duration)
+	})
+	summary.Slowest = executed[:min(len(executed), slowestSpecs)]
+
+	return summary
+}
+
+// Print logs the summary in a human-readable form.
+func (s *Summary) Print() {
+	log.Printf("Ran %d of %d specs in %v: %d passed, %d failed, %d skipped.",
+		s.Passed+s.Failed, s.Total, s.Duration.Round(time.Second), s.Passed, s.Failed, s.Skipped)
+
+	if len(s.Slowest) > 0 {
+		log.Println("Slowest tests:")
+		for _, spec := range s.Slowest {
+			log.Printf("  %8v  %s", seconds(spec.Duration).Round(time.Second), spec.Name)
+		}
+	}
+
+	for _, f := range s.SuiteFailures {
+		log.Errorf("Test suite failed in %s: %s", f.Name, f.Message)
+	}
+
+	if len(s.Failures) > 0 {
+		log.Errorf("Failed tests:")
+		for _, f := range s.Failures {
+			log.Errorf("  %s", f.Name)
+
+			if f.Message != "" {
+				log.Errorf("    %s", strings.ReplaceAll(f.Message, "\n", "\n    "))
+			}
+
+			if f.Location != "" {
+				log.Errorf("    at %s", f.Location)
+			}
+		}
+	}
+}
+
+// failure extracts the failure message and location of a failed test case.
+func failure(tc *junit.TestCase) (string, string) {
+	f := tc.Failure
+	if f == nil {
+		f = tc.Error
+	}
+
+	message, location := parseFailure(f.Description)
+	if f.Message != "" {
+		message = f.Message
+	}
+
+	return message, location
+}
+
+// parseFailure splits a failure description written by Ginkgo, such as
+//
+//	[FAILED] expected pod to be running
+//	In [It] at: k8s.io/kubernetes/test/e2e/node/pods.go:123 @ 01/01/26 10:00:00.000
+//
+// into the failure message and its source location.
+func parseFailure(description string) (string, string) {
+	lines := []string{}
+	location := ""
+
+	for _, line := range strings.Split(description, "\n") {
+		if rest, ok := strings.CutPrefix(line, "In ["); ok {
+			if _, at, ok := strings.Cut(rest, "] at: "); ok {
+				location, _, _ = strings.Cut(at, " @ ")
+				break
+			}
+		}
+
+		lines = append(lines, line)
+	}
+
+	message := strings.TrimSpace(strings.Join(lines, "\n"))
+
+	// strip the state prefix, e.g. [FAILED] or [TIMEDOUT]
+	if strings.HasPrefix(message, "[") {
+		if _, rest, ok := strings.Cut(message, "] "); ok {
+			message = rest
+		}
+	}
+
+	return message, location
+}
+
+// seconds converts a duration in seconds as found in JUnit reports.
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
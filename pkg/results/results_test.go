@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const report = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="5" disabled="0" errors="0" failures="1" time="125.5">
+  <testsuite name="Kubernetes e2e suite" package="/usr/local/bin" tests="5" disabled="0" skipped="1" errors="0" failures="1" time="125.5" timestamp="2026-01-01T10:00:00">
+    <testcase name="[SynchronizedBeforeSuite]" classname="Kubernetes e2e suite" status="passed" time="5"></testcase>
+    <testcase name="[It] [sig-apps] Deployment should work [Conformance]" classname="Kubernetes e2e suite" status="passed" time="10.5"></testcase>
+    <testcase name="[It] [sig-node] Pods should be evicted [Serial] [Conformance]" classname="Kubernetes e2e suite" status="failed" time="100">
+      <failure message="" type="failed">[FAILED] timed out waiting for the condition&#xA;In [It] at: k8s.io/kubernetes/test/e2e/node/pods.go:123 @ 01/01/26 10:01:40.000&#xA;</failure>
+    </testcase>
+    <testcase name="[It] [sig-storage] Volumes should mount [Conformance]" classname="Kubernetes e2e suite" status="passed" time="10"></testcase>
+    <testcase name="[It] [sig-network] Services should serve [Slow]" classname="Kubernetes e2e suite" status="skipped" time="0">
+      <skipped message="skipped"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, junit.ReportFile), []byte(report), 0o644))
+
+	summary, err := Load(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, summary.Total)
+	assert.Equal(t, 2, summary.Passed)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Equal(t, 1, summary.Skipped)
+	assert.Equal(t, 125500*time.Millisecond, summary.Duration)
+
+	failed := TestResult{
+		Name:     "[sig-node] Pods should be evicted [Serial] [Conformance]",
+		Status:   junit.StatusFailed,
+		Duration: 100,
+		Message:  "timed out waiting for the condition",
+		Location: "k8s.io/kubernetes/test/e2e/node/pods.go:123",
+	}
+	deployment := TestResult{Name: "[sig-apps] Deployment should work [Conformance]", Status: junit.StatusPassed, Duration: 10.5}
+	volumes := TestResult{Name: "[sig-storage] Volumes should mount [Conformance]", Status: junit.StatusPassed, Duration: 10}
+	services := TestResult{Name: "[sig-network] Services should serve [Slow]", Status: junit.StatusSkipped}
+
+	assert.Equal(t, []TestResult{deployment, failed, volumes, services}, summary.Tests)
+	assert.Equal(t, []TestResult{failed}, summary.Failures)
+	assert.Equal(t, []TestResult{failed, deployment, volumes}, summary.Slowest)
+}
+
+func TestLoadMissingReport(t *testing.T) {
+	_, err := Load(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestParseFailure(t *testing.T) {
+	testcases := []struct {
+		name             string
+		description      string
+		expectedMessage  string
+		expectedLocation string
+	}{
+		{
+			name:             "failure with location",
+			description:      "[FAILED] expected pod to be running\nIn [It] at: k8s.io/kubernetes/test/e2e/node/pods.go:42 @ 01/01/26 10:00:00.000\n",
+			expectedMessage:  "expected pod to be running",
+			expectedLocation: "k8s.io/kubernetes/test/e2e/node/pods.go:42",
+		},
+		{
+			name:             "multi-line message with stack trace",
+			description:      "[TIMEDOUT] A suite timeout occurred\nwhile waiting\nIn [AfterEach] at: k8s.io/kubernetes/test/e2e/framework/framework.go:200 @ 01/01/26 10:00:00.000\n\nFull Stack Trace\n  foo()",
+			expectedMessage:  "A suite timeout occurred\nwhile waiting",
+			expectedLocation: "k8s.io/kubernetes/test/e2e/framework/framework.go:200",
+		},
+		{
+			name:            "plain message",
+			description:     "something went wrong",
+			expectedMessage: "something went wrong",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			message, location := parseFailure(tc.description)
+			assert.Equal(t, tc.expectedMessage, message)
+			assert.Equal(t, tc.expectedLocation, location)
+		})
+	}
+}
+
+func TestRunWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, junit.ReportFile), []byte(report), 0o644))
+
+	summary, err := Load(dir)
+	require.NoError(t, err)
+
+	run := &Run{
+		Configuration:    types.NewDefaultConfiguration(),
+		ServerVersion:    &version.Info{GitVersion: "v1.33.1"},
+		ConformanceImage: "registry.k8s.io/conformance:v1.33.1",
+		StartTime:        time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:          time.Date(2026, 1, 1, 10, 2, 5, 0, time.UTC),
+		ExitCode:         1,
+		Results:          summary,
+	}
+
+	filename := filepath.Join(dir, SummaryFile)
+	require.NoError(t, run.WriteFile(filename))
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+
+	decoded := map[string]any{}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	assert.Equal(t, "registry.k8s.io/conformance:v1.33.1", decoded["conformanceImage"])
+	assert.Equal(t, "2026-01-01T10:00:00Z", decoded["startTime"])
+	assert.InDelta(t, 1, decoded["exitCode"], 0)
+	assert.Equal(t, "conformance", decoded["configuration"].(map[string]any)["namespace"])
+	assert.Equal(t, "v1.33.1", decoded["serverVersion"].(map[string]any)["gitVersion"])
+
+	counts := decoded["results"].(map[string]any)
+	assert.InDelta(t, 1, counts["failed"], 0)
+	assert.Len(t, counts["tests"], 4)
+}
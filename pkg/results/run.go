@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// SummaryFile is the name of the machine-readable run summary in the output directory.
+const SummaryFile = "summary.json"
+
+// Run is the machine-readable summary of a test run.
+type Run struct {
+	// Command is the hydrophone invocation that started the run.
+	Command string `json:"command,omitempty"`
+	// HydrophoneVersion is the version of hydrophone that started the run, if known.
+	HydrophoneVersion string `json:"hydrophoneVersion,omitempty"`
+	// Focus is the regular expression that selected the specs of the run.
+	Focus            string              `json:"focus"`
+	Configuration    types.Configuration `json:"configuration"`
+	ServerVersion    *version.Info       `json:"serverVersion,omitempty"`
+	ConformanceImage string              `json:"conformanceImage"`
+	// ConformanceImageDigest is the digest the conformance image was pinned to.
+	ConformanceImageDigest string `json:"conformanceImageDigest,omitempty"`
+	// Versions is the version matrix of the cluster and the conformance image.
+	Versions  *Versions `json:"versions,omitempty"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// ExitCode is the exit code of hydrophone, after applying the known failures.
+	ExitCode int `json:"exitCode"`
+	// TestExitCode is the exit code of the test suite.
+	TestExitCode int `json:"testExitCode"`
+	// ImageMismatches describe the containers that did not run the images they
+	// were pinned to. Any mismatch fails the run.
+	ImageMismatches []string `json:"imageMismatches,omitempty"`
+	// Results and Verdict are nil if the JUnit report of the run could not be read.
+	Results *Summary `json:"results,omitempty"`
+	Verdict *Verdict `json:"verdict,omitempty"`
+}
+
+// LoadRunFile reads a run summary previously written by WriteFile.
+func LoadRunFile(filename string) (*Run, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &Run{}
+	if err := json.Unmarshal(data, run); err != nil {
+		return nil, fmt.Errorf("invalid run summary %s: %w", filename, err)
+	}
+
+	return run, nil
+}
+
+// WriteFile stores the run summary as JSON.
+func (r *Run) WriteFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := PrintJSON(f, r); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// PrintJSON writes v as indented JSON.
+func PrintJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Versions is the version matrix of the cluster under test and the conformance image.
+type Versions struct {
+	Server           string         `json:"server"`
+	ConformanceImage string         `json:"conformanceImage"`
+	Nodes            []NodeVersions `json:"nodes"`
+}
+
+// NodeVersions are the versions of the components running on a node. The
+// kube-proxy version is empty if the node does not report it.
+type NodeVersions struct {
+	Name      string `json:"name"`
+	Kubelet   string `json:"kubelet"`
+	KubeProxy string `json:"kubeProxy,omitempty"`
+}
+
+// Print writes the version matrix as a table.
+func (v *Versions) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "COMPONENT\tNODE\tVERSION")
+	fmt.Fprintf(tw, "kube-apiserver\t-\t%s\n", v.Server)
+	fmt.Fprintf(tw, "conformance image\t-\t%s\n", orUnknown(v.ConformanceImage))
+
+	for _, node := range v.Nodes {
+		fmt.Fprintf(tw, "kubelet\t%s\t%s\n", node.Name, orUnknown(node.Kubelet))
+		fmt.Fprintf(tw, "kube-proxy\t%s\t%s\n", node.Name, orUnknown(node.KubeProxy))
+	}
+
+	return tw.Flush()
+}
+
+// orUnknown returns the version, or "unknown" if it is empty.
+func orUnknown(version string) string {
+	if version == "" {
+		return "unknown"
+	}
+
+	return version
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package results
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionsPrint(t *testing.T) {
+	versions := &Versions{
+		Server: "v1.35.1",
+		Nodes:  []NodeVersions{{Name: "node-1", Kubelet: "v1.35.1"}},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, versions.Print(buf))
+	assert.Equal(t, `COMPONENT          NODE    VERSION
+kube-apiserver     -       v1.35.1
+conformance image  -       unknown
+kubelet            node-1  v1.35.1
+kube-proxy         node-1  unknown
+`, buf.String())
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// OIDs of the certificate extensions the OIDC issuer of the signer is stored in.
+var (
+	oidIssuer   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// bundle is the transparency log entry of a signature, as stored by cosign.
+type bundle struct {
+	SignedEntryTimestamp []byte    `json:"SignedEntryTimestamp"`
+	Payload              logRecord `json:"Payload"`
+}
+
+// logRecord is the signed part of a transparency log entry. The fields are in
+// lexical order, so that it marshals to canonical JSON.
+type logRecord struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// hashedRekord is the body of a transparency log entry of a signature.
+type hashedRekord struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content []byte `json:"content"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// verifyCertificate verifies a signature made with a short-lived signing
+// certificate. The certificate must have been valid when the signature was added
+// to the transparency log, must chain up to the trust bundle and must be issued
+// for the expected identity.
+func (v *Verifier) verifyCertificate(payload, sig []byte, annotations map[string]string) error {
+	certs, err := parseCertificates(annotations[CertificateAnnotation] + annotations[ChainAnnotation])
+	if err != nil {
+		return fmt.Errorf("malformed signing certificate: %w", err)
+	}
+
+	cert := certs[0]
+
+	signedAt, err := v.verifyBundle(annotations[BundleAnnotation], payload, sig)
+	if err != nil {
+		return err
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, c := range slices.Concat(v.intermediates, certs[1:]) {
+		intermediates.AddCert(c)
+	}
+
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return fmt.Errorf("untrusted signing certificate: %w", err)
+	}
+
+	if identities := certificateIdentities(cert); !slices.Contains(identities, v.identity) {
+		return fmt.Errorf("signing certificate is issued for %v, not %s", identities, v.identity)
+	}
+
+	if issuer := certificateIssuer(cert); v.issuer != "" && issuer != v.issuer {
+		return fmt.Errorf("signing certificate is issued by %q, not %s", issuer, v.issuer)
+	}
+
+	return verifySignature(cert.PublicKey, payload, sig)
+}
+
+// verifyBundle checks that the transparency log entry is signed by a log key of
+// the trust bundle and records the signature of the payload. It returns the time
+// the entry was added to the log.
+func (v *Verifier) verifyBundle(annotation string, payload, sig []byte) (time.Time, error) {
+	if annotation == "" {
+		return time.Time{}, errors.New("signature has no transparency log bundle")
+	}
+
+	b := bundle{}
+	if err := json.Unmarshal([]byte(annotation), &b); err != nil {
+		return time.Time{}, fmt.Errorf("malformed transparency log bundle: %w", err)
+	}
+
+	canonical, err := canonicalJSON(b.Payload)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !slices.ContainsFunc(v.logKeys, func(key crypto.PublicKey) bool { return verifySignature(key, canonical, b.SignedEntryTimestamp) == nil }) {
+		return time.Time{}, errors.New("transparency log bundle is not signed by a trusted log")
+	}
+
+	body, err := base64.StdEncoding.DecodeString(b.Payload.Body)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed transparency log entry: %w", err)
+	}
+
+	entry := hashedRekord{}
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return time.Time{}, fmt.Errorf("malformed transparency log entry: %w", err)
+	}
+
+	hash := sha256.Sum256(payload)
+	if entry.Kind != "hashedrekord" || entry.Spec.Data.Hash.Value != hex.EncodeToString(hash[:]) || !bytes.Equal(entry.Spec.Signature.Content, sig) {
+		return time.Time{}, errors.New("transparency log entry does not record the signature")
+	}
+
+	return time.Unix(b.Payload.IntegratedTime, 0), nil
+}
+
+// canonicalJSON marshals the log record without HTML escaping or a trailing newline.
+func canonicalJSON(record logRecord) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(record); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// parseCertificates parses PEM encoded certificates, the first of which is the
+// signing certificate.
+func parseCertificates(data string) ([]*x509.Certificate, error) {
+	_, certs, err := parsePEM([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+
+	return certs, nil
+}
+
+// certificateIdentities returns the email addresses and URIs a certificate is issued for.
+func certificateIdentities(cert *x509.Certificate) []string {
+	identities := slices.Clone(cert.EmailAddresses)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+
+	return identities
+}
+
+// certificateIssuer returns the OIDC issuer stored in a signing certificate.
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidIssuer):
+			return string(ext.Value)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package signature verifies cosign signatures of container images without
+// contacting any service besides the registry the signatures are stored in.
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/registry"
+)
+
+// Media type and annotations of the layers of cosign signature manifests.
+const (
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	SignatureAnnotation    = "dev.cosignproject.cosign/signature"
+	CertificateAnnotation  = "dev.sigstore.cosign/certificate"
+	ChainAnnotation        = "dev.sigstore.cosign/chain"
+	BundleAnnotation       = "dev.sigstore.cosign/bundle"
+)
+
+// maxPayloadSize limits the size of the signed payloads read from the registry.
+const maxPayloadSize = 1 << 20
+
+// ErrInvalidSignature is returned if a signature does not match the payload.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Options configure how signatures are verified. Either a public key or a trust
+// bundle and the expected identity must be given.
+type Options struct {
+	// KeyFile is the PEM encoded public key the images must be signed with.
+	KeyFile string
+	// TrustBundleFile contains the PEM encoded certificates of the authorities
+	// issuing signing certificates and the public keys of the transparency log.
+	TrustBundleFile string
+	// Identity is the email or URI the signing certificate must be issued for.
+	Identity string
+	// Issuer is the OIDC issuer the signing certificate must be issued by, if set.
+	Issuer string
+}
+
+// Verifier verifies the signatures of images stored next to them in the registry.
+type Verifier struct {
+	client *registry.Client
+
+	key crypto.PublicKey
+
+	roots         *x509.CertPool
+	intermediates []*x509.Certificate
+	logKeys       []crypto.PublicKey
+	identity      string
+	issuer        string
+}
+
+// NewVerifier loads the key or trust bundle of the options.
+func NewVerifier(client *registry.Client, opts Options) (*Verifier, error) {
+	v := &Verifier{
+		client:   client,
+		identity: opts.Identity,
+		issuer:   opts.Issuer,
+	}
+
+	switch {
+	case opts.KeyFile != "":
+		keys, _, err := loadPEM(opts.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(keys) != 1 {
+			return nil, fmt.Errorf("%s must contain exactly one public key", opts.KeyFile)
+		}
+
+		v.key = keys[0]
+
+	case opts.TrustBundleFile != "":
+		if opts.Identity == "" {
+			return nil, errors.New("verifying signatures using a trust bundle requires an identity")
+		}
+
+		keys, certs, err := loadPEM(opts.TrustBundleFile)
+		if err != nil {
+			return nil, err
+		}
+
+		v.roots = x509.NewCertPool()
+		for _, cert := range certs {
+			if cert.CheckSignatureFrom(cert) == nil {
+				v.roots.AddCert(cert)
+			} else {
+				v.intermediates = append(v.intermediates, cert)
+			}
+		}
+
+		if len(certs) == len(v.intermediates) {
+			return nil, fmt.Errorf("%s contains no root certificate", opts.TrustBundleFile)
+		}
+
+		if len(keys) == 0 {
+			return nil, fmt.Errorf("%s contains no transparency log key", opts.TrustBundleFile)
+		}
+
+		v.logKeys = keys
+
+	default:
+		return nil, errors.New("either a public key or a trust bundle is required")
+	}
+
+	return v, nil
+}
+
+// Verify checks that the image, which must be referenced by digest, has at
+// least one valid signature.
+func (v *Verifier) Verify(ctx context.Context, image string) error {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return err
+	}
+
+	if ref.Digest == "" {
+		return fmt.Errorf("%s must be referenced by digest", image)
+	}
+
+	sigRef := registry.Reference{
+		Registry:   ref.Registry,
+		Repository: ref.Repository,
+		Tag:        strings.Replace(ref.Digest, ":", "-", 1) + ".sig",
+	}
+
+	manifest, err := v.client.Manifest(ctx, sigRef)
+	if errors.Is(err, registry.ErrNotFound) {
+		return fmt.Errorf("%s is not signed", image)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch signatures of %s: %w", image, err)
+	}
+
+	errs := []error{}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != SimpleSigningMediaType {
+			continue
+		}
+
+		if err := v.verifyLayer(ctx, sigRef, layer, ref.Digest); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return nil
+	}
+
+	if len(errs) == 0 {
+		return fmt.Errorf("%s has no cosign signatures", image)
+	}
+
+	return fmt.Errorf("no valid signature for %s: %w", image, errors.Join(errs...))
+}
+
+// verifyLayer downloads the signed payload of a signature layer and verifies it.
+func (v *Verifier) verifyLayer(ctx context.Context, ref registry.Reference, layer registry.Descriptor, digest string) error {
+	blob, err := v.client.Blob(ctx, ref, layer.Digest)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	payload, err := io.ReadAll(io.LimitReader(blob, maxPayloadSize))
+	if err != nil {
+		return fmt.Errorf("failed to read signed payload: %w", err)
+	}
+
+	if registry.Digest(payload) != layer.Digest {
+		return fmt.Errorf("signed payload does not match digest %s", layer.Digest)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(layer.Annotations[SignatureAnnotation])
+	if err != nil {
+		return fmt.Errorf("malformed signature: %w", err)
+	}
+
+	return v.verifyPayload(payload, sig, layer.Annotations, digest)
+}
+
+// verifyPayload checks the signature of a simple signing payload and that the
+// payload refers to the image digest.
+func (v *Verifier) verifyPayload(payload, sig []byte, annotations map[string]string, digest string) error {
+	if v.key != nil {
+		if err := verifySignature(v.key, payload, sig); err != nil {
+			return err
+		}
+	} else if err := v.verifyCertificate(payload, sig, annotations); err != nil {
+		return err
+	}
+
+	simpleSigning := struct {
+		Critical struct {
+			Image struct {
+				DockerManifestDigest string `json:"docker-manifest-digest"`
+			} `json:"image"`
+		} `json:"critical"`
+	}{}
+
+	if err := json.Unmarshal(payload, &simpleSigning); err != nil {
+		return fmt.Errorf("malformed signed payload: %w", err)
+	}
+
+	if signed := simpleSigning.Critical.Image.DockerManifestDigest; signed != digest {
+		return fmt.Errorf("signature is for digest %s", signed)
+	}
+
+	return nil
+}
+
+// verifySignature verifies the signature of the SHA-256 hash of the payload, or
+// of the payload itself for ed25519 keys.
+func verifySignature(key crypto.PublicKey, payload, sig []byte) error {
+	hash := sha256.Sum256(payload)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hash[:], sig) {
+			return ErrInvalidSignature
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], sig) != nil {
+			return ErrInvalidSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, sig) {
+			return ErrInvalidSignature
+		}
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+
+	return nil
+}
+
+// loadPEM reads the public keys and certificates of a PEM file.
+func loadPEM(filename string) ([]crypto.PublicKey, []*x509.Certificate, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys, certs, err := parsePEM(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %s: %w", filename, err)
+	}
+
+	return keys, certs, nil
+}
+
+// parsePEM parses the public keys and certificates of PEM encoded data.
+func parsePEM(data []byte) ([]crypto.PublicKey, []*x509.Certificate, error) {
+	keys := []crypto.PublicKey{}
+	certs := []*x509.Certificate{}
+
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			keys = append(keys, key)
+
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			certs = append(certs, cert)
+		}
+	}
+
+	if len(keys) == 0 && len(certs) == 0 {
+		return nil, nil, errors.New("no public keys or certificates found")
+	}
+
+	return keys, certs, nil
+}
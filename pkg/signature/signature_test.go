@@ -0,0 +1,274 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/hydrophone/pkg/registry"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const imageDigest = "sha256:ee6521f290b2168b6e0935a181d4cff9be1ac3f505666ef0e3c98fae8199917a"
+
+// payload is the simple signing payload cosign signs for the image.
+var payload = []byte(`{"critical":{"identity":{"docker-reference":"registry.k8s.io/conformance"},"image":{"docker-manifest-digest":"` +
+	imageDigest + `"},"type":"cosign container image signature"},"optional":null}`)
+
+// fakeRegistry serves the manifests and blobs of a single repository, keyed by
+// tag or digest.
+type fakeRegistry map[string][]byte
+
+func (f fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	_, name, _ := strings.Cut(r.URL.Path, "/conformance/")
+	_, name, _ = strings.Cut(name, "/")
+
+	data, ok := f[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if strings.Contains(r.URL.Path, "/manifests/") {
+		w.Header().Set("Content-Type", registry.MediaTypeOCIManifest)
+	}
+
+	_, _ = w.Write(data)
+}
+
+// signPayload signs the payload with the key.
+func signPayload(t *testing.T, key crypto.Signer) []byte {
+	hash := sha256.Sum256(payload)
+	sig, err := key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	require.NoError(t, err)
+
+	return sig
+}
+
+// addSignature stores the signature manifest of the image with a single signature
+// of the payload, with the given annotations.
+func (f fakeRegistry) addSignature(t *testing.T, sig []byte, annotations map[string]string) {
+	f[registry.Digest(payload)] = payload
+	annotations[SignatureAnnotation] = base64.StdEncoding.EncodeToString(sig)
+
+	manifest, err := json.Marshal(registry.Manifest{
+		MediaType: registry.MediaTypeOCIManifest,
+		Layers: []registry.Descriptor{{
+			MediaType:   SimpleSigningMediaType,
+			Digest:      registry.Digest(payload),
+			Size:        int64(len(payload)),
+			Annotations: annotations,
+		}},
+	})
+	require.NoError(t, err)
+
+	f[strings.Replace(imageDigest, ":", "-", 1)+".sig"] = manifest
+}
+
+func newFakeRegistry(t *testing.T) (fakeRegistry, string) {
+	f := fakeRegistry{}
+
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	return f, strings.TrimPrefix(server.URL, "http://") + "/conformance@" + imageDigest
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return key
+}
+
+// writePEM writes the public keys and certificates to a PEM file.
+func writePEM(t *testing.T, keys []crypto.PublicKey, certs []*x509.Certificate) string {
+	filename := filepath.Join(t.TempDir(), "keys.pem")
+	data := []byte{}
+
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		require.NoError(t, err)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})...)
+	}
+
+	for _, cert := range certs {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+
+	require.NoError(t, os.WriteFile(filename, data, 0o644))
+
+	return filename
+}
+
+func TestVerifyKey(t *testing.T) {
+	fake, image := newFakeRegistry(t)
+	key := newKey(t)
+
+	verifier, err := NewVerifier(registry.NewClient(nil), Options{KeyFile: writePEM(t, []crypto.PublicKey{key.Public()}, nil)})
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, verifier.Verify(context.Background(), image), "is not signed")
+
+	fake.addSignature(t, signPayload(t, key), map[string]string{})
+	assert.NoError(t, verifier.Verify(context.Background(), image))
+
+	fake.addSignature(t, signPayload(t, newKey(t)), map[string]string{})
+	assert.ErrorIs(t, verifier.Verify(context.Background(), image), ErrInvalidSignature)
+
+	assert.ErrorContains(t, verifier.Verify(context.Background(), strings.TrimSuffix(image, "@"+imageDigest)+":v1.35.1"), "must be referenced by digest")
+}
+
+func TestVerifyTrustBundle(t *testing.T) {
+	fake, image := newFakeRegistry(t)
+	caKey, logKey, signingKey := newKey(t), newKey(t), newKey(t)
+	signedAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fake-fulcio"},
+		NotBefore:             signedAt.Add(-time.Hour),
+		NotAfter:              signedAt.Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issuer, err := asn1.Marshal("https://accounts.google.com")
+	require.NoError(t, err)
+
+	// signing certificates are only valid for a few minutes
+	leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       signedAt.Add(-time.Minute),
+		NotAfter:        signedAt.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{"krel-trust@k8s-releng-prod.iam.gserviceaccount.com"},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}, ca, signingKey.Public(), caKey)
+	require.NoError(t, err)
+
+	annotations := map[string]string{
+		CertificateAnnotation: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})),
+	}
+	sig := signPayload(t, signingKey)
+
+	hash := sha256.Sum256(payload)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data":      map[string]any{"hash": map[string]string{"algorithm": "sha256", "value": hex.EncodeToString(hash[:])}},
+			"signature": map[string]any{"content": sig},
+		},
+	})
+	require.NoError(t, err)
+
+	record := logRecord{Body: base64.StdEncoding.EncodeToString(body), IntegratedTime: signedAt.Unix(), LogID: "fake", LogIndex: 42}
+	canonical, err := canonicalJSON(record)
+	require.NoError(t, err)
+	canonicalHash := sha256.Sum256(canonical)
+	set, err := logKey.Sign(rand.Reader, canonicalHash[:], crypto.SHA256)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(bundle{SignedEntryTimestamp: set, Payload: record})
+	require.NoError(t, err)
+	annotations[BundleAnnotation] = string(b)
+	fake.addSignature(t, sig, annotations)
+
+	trustBundle := writePEM(t, []crypto.PublicKey{logKey.Public()}, []*x509.Certificate{ca})
+
+	testCases := []struct {
+		name        string
+		identity    string
+		issuer      string
+		expectedErr string
+	}{
+		{
+			name:     "trusted identity",
+			identity: "krel-trust@k8s-releng-prod.iam.gserviceaccount.com",
+			issuer:   "https://accounts.google.com",
+		},
+		{
+			name:        "other identity",
+			identity:    "someone@example.com",
+			expectedErr: "not someone@example.com",
+		},
+		{
+			name:        "other issuer",
+			identity:    "krel-trust@k8s-releng-prod.iam.gserviceaccount.com",
+			issuer:      "https://token.actions.githubusercontent.com",
+			expectedErr: `issued by "https://accounts.google.com"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier, err := NewVerifier(registry.NewClient(nil), Options{TrustBundleFile: trustBundle, Identity: tc.identity, Issuer: tc.issuer})
+			require.NoError(t, err)
+
+			err = verifier.Verify(context.Background(), image)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	// a log key that is not part of the trust bundle is rejected
+	untrusted := writePEM(t, []crypto.PublicKey{newKey(t).Public()}, []*x509.Certificate{ca})
+	verifier, err := NewVerifier(registry.NewClient(nil), Options{TrustBundleFile: untrusted, Identity: "krel-trust@k8s-releng-prod.iam.gserviceaccount.com"})
+	require.NoError(t, err)
+	assert.ErrorContains(t, verifier.Verify(context.Background(), image), "not signed by a trusted log")
+}
+
+func TestNewVerifier(t *testing.T) {
+	_, err := NewVerifier(registry.NewClient(nil), Options{})
+	assert.Error(t, err)
+
+	keyFile := writePEM(t, []crypto.PublicKey{newKey(t).Public()}, nil)
+	_, err = NewVerifier(registry.NewClient(nil), Options{TrustBundleFile: keyFile, Identity: "someone@example.com"})
+	assert.ErrorContains(t, err, "no root certificate")
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submission
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProductFile is the name of the product metadata file of a submission.
+	ProductFile = "PRODUCT.yaml"
+
+	// ReadmeFile is the name of the file describing how to reproduce the results.
+	ReadmeFile = "README.md"
+
+	// LogFile is the name of the e2e log of a submission.
+	LogFile = "e2e.log"
+)
+
+// productTypes are the product types accepted by the CNCF.
+var productTypes = []string{"distribution", "hosted platform", "installer"}
+
+var (
+	//go:embed README.md.tmpl
+	readmeTemplate string
+
+	// nonAlphanumeric matches everything that cannot be part of a product directory name.
+	nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Product is the metadata of the certified product, as stored in PRODUCT.yaml.
+type Product struct {
+	Vendor           string `yaml:"vendor"`
+	Name             string `yaml:"name"`
+	Version          string `yaml:"version"`
+	WebsiteURL       string `yaml:"website_url"`
+	RepoURL          string `yaml:"repo_url,omitempty"`
+	DocumentationURL string `yaml:"documentation_url,omitempty"`
+	ProductLogoURL   string `yaml:"product_logo_url,omitempty"`
+	Type             string `yaml:"type"`
+	Description      string `yaml:"description"`
+	ContactEmail     string `yaml:"contact_email_address,omitempty"`
+}
+
+// LoadProduct reads product metadata from a YAML file.
+func LoadProduct(filename string) (*Product, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open product file: %w", err)
+	}
+	defer f.Close()
+
+	product := &Product{}
+	if err := yaml.NewDecoder(f).Decode(product); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid product file: %w", err)
+	}
+
+	return product, nil
+}
+
+// Validate checks that all metadata required for a submission is present.
+func (p *Product) Validate() error {
+	required := map[string]string{
+		"vendor":      p.Vendor,
+		"name":        p.Name,
+		"version":     p.Version,
+		"website_url": p.WebsiteURL,
+		"type":        p.Type,
+		"description": p.Description,
+	}
+
+	missing := []string{}
+	for field, value := range required {
+		if strings.TrimSpace(value) == "" {
+			missing = append(missing, field)
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return fmt.Errorf("missing product metadata: %s", strings.Join(missing, ", "))
+	}
+
+	if !slices.Contains(productTypes, p.Type) {
+		return fmt.Errorf("invalid product type %q, must be one of: %s", p.Type, strings.Join(productTypes, ", "))
+	}
+
+	return nil
+}
+
+// DirName returns the name of the product directory within a submission.
+func (p *Product) DirName() string {
+	return strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(p.Name), "-"), "-")
+}
+
+// Submission describes a submission for the CNCF Kubernetes conformance program.
+type Submission struct {
+	Product *Product
+	// KubernetesVersion is the minor version the product is certified for, e.g. v1.33.
+	KubernetesVersion string
+	// Command is the hydrophone invocation that produced the results.
+	Command string
+	// HydrophoneVersion is the version of hydrophone that produced the results.
+	// If it is unknown, the README refers to the latest version.
+	HydrophoneVersion string
+}
+
+// Create assembles the submission from the results in resultsDir. The files are
+// written into <outputDir>/<KubernetesVersion>/<product>/, whose path is returned.
+func (s *Submission) Create(resultsDir, outputDir string) (string, error) {
+	dir := filepath.Join(outputDir, s.KubernetesVersion, s.Product.DirName())
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create submission directory: %w", err)
+	}
+
+	for _, filename := range []string{LogFile, junit.ReportFile} {
+		if err := copyFile(filepath.Join(resultsDir, filename), filepath.Join(dir, filename)); err != nil {
+			return "", err
+		}
+	}
+
+	productData, err := yaml.Marshal(s.Product)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode product metadata: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ProductFile), productData, 0o644); err != nil {
+		return "", fmt.Errorf("failed to write product metadata: %w", err)
+	}
+
+	readme, err := os.Create(filepath.Join(dir, ReadmeFile))
+	if err != nil {
+		return "", fmt.Errorf("failed to create README: %w", err)
+	}
+	defer readme.Close()
+
+	if err := s.renderReadme(readme); err != nil {
+		return "", err
+	}
+
+	return dir, readme.Close()
+}
+
+// renderReadme writes the README describing how to reproduce the results.
+func (s *Submission) renderReadme(w io.Writer) error {
+	tmpl, err := template.New("readme").Parse(readmeTemplate)
+	if err != nil {
+		return fmt.Errorf("invalid README template: %w", err)
+	}
+
+	if err := tmpl.Execute(w, s); err != nil {
+		return fmt.Errorf("failed to render README: %w", err)
+	}
+
+	return nil
+}
+
+// copyFile copies a result file into the submission.
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return fmt.Errorf("failed to read results: %w", err)
+	}
+
+	return os.WriteFile(dst, data, 0o644)
+}
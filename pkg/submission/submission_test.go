@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submission
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func testProduct() *Product {
+	return &Product{
+		Vendor:           "Example Corp",
+		Name:             "Example Kubernetes Engine",
+		Version:          "2.1.0",
+		WebsiteURL:       "https://example.com",
+		DocumentationURL: "https://example.com/docs",
+		Type:             "hosted platform",
+		Description:      "A managed Kubernetes service.",
+	}
+}
+
+func TestProductValidate(t *testing.T) {
+	assert.NoError(t, testProduct().Validate())
+
+	invalidType := testProduct()
+	invalidType.Type = "appliance"
+	assert.EqualError(t, invalidType.Validate(), `invalid product type "appliance", must be one of: distribution, hosted platform, installer`)
+
+	assert.EqualError(t, (&Product{Vendor: "Example Corp"}).Validate(), "missing product metadata: description, name, type, version, website_url")
+}
+
+func TestProductDirName(t *testing.T) {
+	assert.Equal(t, "example-kubernetes-engine", testProduct().DirName())
+	assert.Equal(t, "k3s", (&Product{Name: " K3s! "}).DirName())
+}
+
+func TestCreate(t *testing.T) {
+	resultsDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(resultsDir, LogFile), []byte("log"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(resultsDir, junit.ReportFile), []byte("<testsuites/>"), 0o644))
+
+	sub := &Submission{
+		Product:           testProduct(),
+		KubernetesVersion: "v1.33",
+		Command:           "hydrophone --conformance --output-dir ./results",
+		HydrophoneVersion: "v0.7.0",
+	}
+
+	outputDir := t.TempDir()
+	dir, err := sub.Create(resultsDir, outputDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(outputDir, "v1.33", "example-kubernetes-engine"), dir)
+
+	data, err := os.ReadFile(filepath.Join(dir, LogFile))
+	require.NoError(t, err)
+	assert.Equal(t, "log", string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, ProductFile))
+	require.NoError(t, err)
+	product := &Product{}
+	require.NoError(t, yaml.Unmarshal(data, product))
+	assert.Equal(t, testProduct(), product)
+	assert.Contains(t, string(data), "website_url: https://example.com")
+
+	data, err = os.ReadFile(filepath.Join(dir, ReadmeFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "hydrophone --conformance --output-dir ./results")
+	assert.Contains(t, string(data), "go install sigs.k8s.io/hydrophone@v0.7.0")
+	assert.Contains(t, string(data), "Kubernetes v1.33, following the documentation at https://example.com/docs.")
+}
+
+func TestRenderReadmeWithoutVersion(t *testing.T) {
+	sub := &Submission{Product: testProduct(), KubernetesVersion: "v1.33", Command: "hydrophone --conformance"}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, sub.renderReadme(buf))
+	assert.Contains(t, buf.String(), "go install sigs.k8s.io/hydrophone@latest")
+}
+
+func TestCreateMissingResults(t *testing.T) {
+	sub := &Submission{Product: testProduct(), KubernetesVersion: "v1.33"}
+
+	_, err := sub.Create(t.TempDir(), t.TempDir())
+	assert.Error(t, err)
+}
@@ -0,0 +1,247 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submission
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/registry"
+	"sigs.k8s.io/hydrophone/pkg/results"
+
+	"github.com/blang/semver/v4"
+)
+
+// ConformanceFocus is the focus a run must use to be accepted for certification.
+const ConformanceFocus = `\[Conformance\]`
+
+// Names of the certification rules.
+const (
+	RuleFocus     = "focus"
+	RuleSpecCount = "spec-count"
+	RuleFailures  = "failures"
+	RuleLog       = "log"
+	RuleVersion   = "version"
+)
+
+var (
+	// ranSpecs matches the line Ginkgo prints at the end of a suite, e.g. "Ran 402 of 7000 Specs in 5000.1 seconds".
+	ranSpecs = regexp.MustCompile(`Ran (\d+) of \d+ Specs`)
+
+	// specCounts matches the outcome Ginkgo prints at the end of a suite, e.g. "SUCCESS! -- 402 Passed | 0 Failed".
+	specCounts = regexp.MustCompile(`(\d+) Passed \| (\d+) Failed`)
+
+	// e2eVersion and serverVersion match the versions the e2e framework logs at startup.
+	e2eVersion    = regexp.MustCompile(`e2e test version: (\S+)`)
+	serverVersion = regexp.MustCompile(`kube-apiserver version: (\S+)`)
+)
+
+// Violation is a certification requirement a result set does not meet.
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// Evidence is everything known about a result set that is needed to verify it.
+type Evidence struct {
+	// Run is the summary written by hydrophone, or nil if it is not available.
+	Run     *results.Run
+	Summary *results.Summary
+	Log     string
+	// ExpectedSpecs is the number of conformance specs of the conformance image, or -1 if unknown.
+	ExpectedSpecs int
+}
+
+// Verify checks a result set against the requirements of the CNCF conformance
+// program and returns all violated rules.
+func Verify(e *Evidence) []Violation {
+	violations := []Violation{}
+
+	for _, check := range []func(*Evidence) []Violation{
+		checkFocus,
+		checkSpecCount,
+		checkFailures,
+		checkLog,
+		checkVersion,
+	} {
+		violations = append(violations, check(e)...)
+	}
+
+	return violations
+}
+
+// checkFocus verifies that all conformance specs were selected.
+func checkFocus(e *Evidence) []Violation {
+	if e.Run == nil {
+		return []Violation{{RuleFocus, "cannot verify the focus without the summary.json of the run"}}
+	}
+
+	violations := []Violation{}
+
+	if e.Run.Focus != ConformanceFocus {
+		violations = append(violations, Violation{RuleFocus, fmt.Sprintf("run used focus %q instead of %q", e.Run.Focus, ConformanceFocus)})
+	}
+
+	if e.Run.Configuration.Skip != "" {
+		violations = append(violations, Violation{RuleFocus, fmt.Sprintf("run skipped tests matching %q", e.Run.Configuration.Skip)})
+	}
+
+	for _, arg := range e.Run.Configuration.ExtraGinkgoArgs {
+		if strings.HasPrefix(arg, "--label-filter=") || strings.HasPrefix(arg, "--focus") || strings.HasPrefix(arg, "--skip") {
+			violations = append(violations, Violation{RuleFocus, fmt.Sprintf("run filtered tests with %q", arg)})
+		}
+	}
+
+	if e.Run.Configuration.DryRun {
+		violations = append(violations, Violation{RuleFocus, "run was a dry-run"})
+	}
+
+	return violations
+}
+
+// checkSpecCount verifies that every conformance spec of the image was executed.
+func checkSpecCount(e *Evidence) []Violation {
+	if e.ExpectedSpecs < 0 {
+		return []Violation{{RuleSpecCount, "cannot verify the number of executed specs without the number of conformance specs of the image"}}
+	}
+
+	executed := 0
+	for _, test := range e.Summary.Tests {
+		if test.Status != junit.StatusSkipped && strings.Contains(test.Name, "[Conformance]") {
+			executed++
+		}
+	}
+
+	if executed != e.ExpectedSpecs {
+		return []Violation{{RuleSpecCount, fmt.Sprintf("%d conformance specs were executed, but the conformance image contains %d", executed, e.ExpectedSpecs)}}
+	}
+
+	return nil
+}
+
+// checkFailures verifies that no spec failed.
+func checkFailures(e *Evidence) []Violation {
+	violations := []Violation{}
+
+	for _, failure := range e.Summary.Failures {
+		violations = append(violations, Violation{RuleFailures, fmt.Sprintf("failed: %s", failure.Name)})
+	}
+
+	return violations
+}
+
+// checkLog verifies that e2e.log reports the same results as junit_01.xml. The log
+// of a run with multiple phases contains one result per phase, which are added up.
+func checkLog(e *Evidence) []Violation {
+	ran := sumMatches(ranSpecs, e.Log, 1)
+	passed := sumMatches(specCounts, e.Log, 1)
+	failed := sumMatches(specCounts, e.Log, 2)
+
+	if ran < 0 || passed < 0 {
+		return []Violation{{RuleLog, "e2e.log does not contain the results of the test suite"}}
+	}
+
+	violations := []Violation{}
+
+	if executed := e.Summary.Passed + e.Summary.Failed; ran != executed {
+		violations = append(violations, Violation{RuleLog, fmt.Sprintf("e2e.log reports %d executed specs, junit_01.xml %d", ran, executed)})
+	}
+
+	if passed != e.Summary.Passed || failed != e.Summary.Failed {
+		violations = append(violations, Violation{RuleLog, fmt.Sprintf("e2e.log reports %d passed and %d failed specs, junit_01.xml %d and %d",
+			passed, failed, e.Summary.Passed, e.Summary.Failed)})
+	}
+
+	return violations
+}
+
+// checkVersion verifies that the version of the conformance image matches the minor
+// version of the cluster.
+func checkVersion(e *Evidence) []Violation {
+	imageVersion, clusterVersion := "", ""
+
+	if e.Run != nil {
+		// the tag is only used if it is a version, e.g. not latest
+		if ref, err := registry.ParseReference(e.Run.ConformanceImage); err == nil {
+			if _, err := MinorVersion(ref.Tag); err == nil {
+				imageVersion = ref.Tag
+			}
+		}
+
+		if e.Run.ServerVersion != nil {
+			clusterVersion = e.Run.ServerVersion.GitVersion
+		}
+	}
+
+	if match := e2eVersion.FindStringSubmatch(e.Log); imageVersion == "" && match != nil {
+		imageVersion = match[1]
+	}
+
+	if match := serverVersion.FindStringSubmatch(e.Log); clusterVersion == "" && match != nil {
+		clusterVersion = match[1]
+	}
+
+	if imageVersion == "" || clusterVersion == "" {
+		return []Violation{{RuleVersion, "cannot determine the versions of the conformance image and the cluster"}}
+	}
+
+	imageMinor, err := MinorVersion(imageVersion)
+	if err != nil {
+		return []Violation{{RuleVersion, fmt.Sprintf("invalid conformance image version %q", imageVersion)}}
+	}
+
+	clusterMinor, err := MinorVersion(clusterVersion)
+	if err != nil {
+		return []Violation{{RuleVersion, fmt.Sprintf("invalid cluster version %q", clusterVersion)}}
+	}
+
+	if imageMinor != clusterMinor {
+		return []Violation{{RuleVersion, fmt.Sprintf("conformance image version %s does not match cluster version %s", imageVersion, clusterVersion)}}
+	}
+
+	return nil
+}
+
+// sumMatches adds up the numbers captured by the given group of all matches, or
+// returns -1 if there is no match.
+func sumMatches(re *regexp.Regexp, s string, group int) int {
+	matches := re.FindAllStringSubmatch(s, -1)
+	if len(matches) == 0 {
+		return -1
+	}
+
+	sum := 0
+	for _, match := range matches {
+		n, _ := strconv.Atoi(match[group])
+		sum += n
+	}
+
+	return sum
+}
+
+// MinorVersion returns the major and minor version of a Kubernetes version, e.g. v1.33.
+func MinorVersion(ver string) (string, error) {
+	parsed, err := semver.ParseTolerant(strings.TrimPrefix(ver, "v"))
+	if err != nil {
+		return "", fmt.Errorf("invalid Kubernetes version %q: %w", ver, err)
+	}
+
+	return fmt.Sprintf("v%d.%d", parsed.Major, parsed.Minor), nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package submission
+
+import (
+	"testing"
+
+	"sigs.k8s.io/hydrophone/pkg/junit"
+	"sigs.k8s.io/hydrophone/pkg/results"
+	"sigs.k8s.io/hydrophone/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const validLog = `I0101 10:00:00.000000      21 e2e.go:109] e2e test version: v1.33.1
+I0101 10:00:00.000000      21 util.go:499] kube-apiserver version: v1.33.2
+Running Suite: Kubernetes e2e suite - /usr/local/bin
+Will run 2 of 7000 specs
+Ran 2 of 7000 Specs in 100.000 seconds
+SUCCESS! -- 2 Passed | 0 Failed | 0 Pending | 6998 Skipped
+`
+
+func validEvidence() *Evidence {
+	return &Evidence{
+		Run: &results.Run{
+			Focus:            ConformanceFocus,
+			Configuration:    types.NewDefaultConfiguration(),
+			ConformanceImage: "registry.example.com:5000/conformance:v1.33.1",
+			ServerVersion:    &version.Info{GitVersion: "v1.33.2-eks-1"},
+		},
+		Summary: &results.Summary{
+			Total:   3,
+			Passed:  2,
+			Skipped: 1,
+			Tests: []results.TestResult{
+				{Name: "[sig-apps] Deployment should work [Conformance]", Status: junit.StatusPassed},
+				{Name: "[sig-node] Pods should run [Conformance]", Status: junit.StatusPassed},
+				{Name: "[sig-node] Pods should be slow [Slow]", Status: junit.StatusSkipped},
+			},
+		},
+		Log:           validLog,
+		ExpectedSpecs: 2,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	testCases := []struct {
+		name     string
+		modify   func(e *Evidence)
+		expected []Violation
+	}{
+		{
+			name:     "valid",
+			modify:   func(*Evidence) {},
+			expected: []Violation{},
+		},
+		{
+			name: "focus and skip",
+			modify: func(e *Evidence) {
+				e.Run.Focus = "sig-node"
+				e.Run.Configuration.Skip = "Slow"
+			},
+			expected: []Violation{
+				{RuleFocus, `run used focus "sig-node" instead of "\\[Conformance\\]"`},
+				{RuleFocus, `run skipped tests matching "Slow"`},
+			},
+		},
+		{
+			name: "missing specs",
+			modify: func(e *Evidence) {
+				e.ExpectedSpecs = 3
+			},
+			expected: []Violation{
+				{RuleSpecCount, "2 conformance specs were executed, but the conformance image contains 3"},
+			},
+		},
+		{
+			name: "failures",
+			modify: func(e *Evidence) {
+				e.Summary.Tests[1].Status = junit.StatusFailed
+				e.Summary.Failures = []results.TestResult{e.Summary.Tests[1]}
+				e.Summary.Passed = 1
+				e.Summary.Failed = 1
+			},
+			expected: []Violation{
+				{RuleFailures, "failed: [sig-node] Pods should run [Conformance]"},
+				{RuleLog, "e2e.log reports 2 passed and 0 failed specs, junit_01.xml 1 and 1"},
+			},
+		},
+		{
+			name: "log without results",
+			modify: func(e *Evidence) {
+				e.Log = "I0101 e2e test version: v1.33.1\n"
+			},
+			expected: []Violation{
+				{RuleLog, "e2e.log does not contain the results of the test suite"},
+			},
+		},
+		{
+			name: "version mismatch",
+			modify: func(e *Evidence) {
+				e.Run.ConformanceImage = "registry.k8s.io/conformance:v1.32.0"
+			},
+			expected: []Violation{
+				{RuleVersion, "conformance image version v1.32.0 does not match cluster version v1.33.2-eks-1"},
+			},
+		},
+		{
+			name: "pinned image in registry with port",
+			modify: func(e *Evidence) {
+				e.Run.ConformanceImage = "registry.example.com:5000/conformance:v1.32.0@sha256:0123"
+			},
+			expected: []Violation{
+				{RuleVersion, "conformance image version v1.32.0 does not match cluster version v1.33.2-eks-1"},
+			},
+		},
+		{
+			name: "image tag is not a version",
+			modify: func(e *Evidence) {
+				e.Run.ConformanceImage = "registry.example.com:5000/conformance:latest"
+			},
+			expected: []Violation{},
+		},
+		{
+			name: "without run summary",
+			modify: func(e *Evidence) {
+				e.Run = nil
+				e.ExpectedSpecs = -1
+			},
+			expected: []Violation{
+				{RuleFocus, "cannot verify the focus without the summary.json of the run"},
+				{RuleSpecCount, "cannot verify the number of executed specs without the number of conformance specs of the image"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evidence := validEvidence()
+			tc.modify(evidence)
+
+			assert.Equal(t, tc.expected, Verify(evidence))
+		})
+	}
+}
+
+func TestMultiPhaseLog(t *testing.T) {
+	evidence := validEvidence()
+	evidence.Log = `e2e test version: v1.33.1
+kube-apiserver version: v1.33.2
+Ran 1 of 7000 Specs in 50.000 seconds
+SUCCESS! -- 1 Passed | 0 Failed | 0 Pending | 6999 Skipped
+Ran 1 of 7000 Specs in 50.000 seconds
+SUCCESS! -- 1 Passed | 0 Failed | 0 Pending | 6999 Skipped
+`
+
+	assert.Empty(t, Verify(evidence))
+}
+
+func TestMinorVersion(t *testing.T) {
+	testCases := []struct {
+		name            string
+		version         string
+		expectedVersion string
+		expectErr       bool
+	}{
+		{
+			name:            "stable version",
+			version:         "v1.33.1",
+			expectedVersion: "v1.33",
+		},
+		{
+			name:            "vendor version",
+			version:         "v1.32.4-gke.1415000",
+			expectedVersion: "v1.32",
+		},
+		{
+			name:            "short version",
+			version:         "1.31",
+			expectedVersion: "v1.31",
+		},
+		{
+			name:      "invalid version",
+			version:   "latest",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minor, err := MinorVersion(tc.version)
+			assert.Equal(t, tc.expectedVersion, minor)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -34,38 +35,123 @@ const (
 	DefaultNamespace = "conformance"
 )
 
+// Output formats for the results printed by hydrophone.
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+	OutputYAML = "yaml"
+	// OutputRepoList prints the images as a KUBE_TEST_REPO_LIST file redirecting them to a mirror.
+	OutputRepoList = "repo-list"
+)
+
 type Configuration struct {
 	configFile string
 
-	Kubeconfig             string        `yaml:"kubeconfig"`
-	Parallel               int           `yaml:"parallel"`
-	Verbosity              int           `yaml:"verbosity"`
-	OutputDir              string        `yaml:"outputDir"`
-	Skip                   string        `yaml:"skip"`
-	ConformanceImage       string        `yaml:"conformanceImage"`
-	BusyboxImage           string        `yaml:"busyboxImage"`
-	Namespace              string        `yaml:"namespace"`
-	DryRun                 bool          `yaml:"dryRun"`
-	TestRepoList           string        `yaml:"testRepoList"`
-	TestRepo               string        `yaml:"testRepo"`
-	ExtraArgs              []string      `yaml:"extraArgs"`
-	ExtraGinkgoArgs        []string      `yaml:"extraGinkgoArgs"`
-	StartupTimeout         time.Duration `yaml:"startupTimeout"`
-	DisableProgressStatus  bool          `yaml:"disableProgressStatus"`
-	ProgressStatusInterval time.Duration `yaml:"progressStatusInterval"`
+	Kubeconfig             string         `yaml:"kubeconfig" json:"kubeconfig"`
+	Parallel               int            `yaml:"parallel" json:"parallel"`
+	Shards                 int            `yaml:"shards" json:"shards"`
+	Verbosity              int            `yaml:"verbosity" json:"verbosity"`
+	OutputDir              string         `yaml:"outputDir" json:"outputDir"`
+	Skip                   string         `yaml:"skip" json:"skip"`
+	ConformanceImage       string         `yaml:"conformanceImage" json:"conformanceImage"`
+	BusyboxImage           string         `yaml:"busyboxImage" json:"busyboxImage"`
+	RequireDigest          bool           `yaml:"requireDigest" json:"requireDigest"`
+	SignatureKey           string         `yaml:"signatureKey" json:"signatureKey,omitempty"`
+	SignatureTrustBundle   string         `yaml:"signatureTrustBundle" json:"signatureTrustBundle,omitempty"`
+	SignatureIdentity      string         `yaml:"signatureIdentity" json:"signatureIdentity,omitempty"`
+	SignatureIssuer        string         `yaml:"signatureIssuer" json:"signatureIssuer,omitempty"`
+	Namespace              string         `yaml:"namespace" json:"namespace"`
+	DryRun                 bool           `yaml:"dryRun" json:"dryRun"`
+	TestRepoList           string         `yaml:"testRepoList" json:"testRepoList"`
+	TestRepo               string         `yaml:"testRepo" json:"testRepo"`
+	MirrorRegistry         string         `yaml:"mirrorRegistry" json:"mirrorRegistry,omitempty"`
+	PreflightImages        bool           `yaml:"preflightImages" json:"preflightImages"`
+	StrictVersion          bool           `yaml:"strictVersion" json:"strictVersion"`
+	PrepullImages          bool           `yaml:"prepullImages" json:"prepullImages"`
+	PrepullTimeout         time.Duration  `yaml:"prepullTimeout" json:"prepullTimeout"`
+	ExtraArgs              []string       `yaml:"extraArgs" json:"extraArgs"`
+	ExtraGinkgoArgs        []string       `yaml:"extraGinkgoArgs" json:"extraGinkgoArgs"`
+	StartupTimeout         time.Duration  `yaml:"startupTimeout" json:"startupTimeout"`
+	DisableProgressStatus  bool           `yaml:"disableProgressStatus" json:"disableProgressStatus"`
+	ProgressStatusInterval time.Duration  `yaml:"progressStatusInterval" json:"progressStatusInterval"`
+	Output                 string         `yaml:"output" json:"output"`
+	KnownFailures          []KnownFailure `yaml:"knownFailures" json:"knownFailures,omitempty"`
+
+	// ConformanceImageDigest and BusyboxImageDigest are the digests the images
+	// were resolved to before deploying the tests.
+	ConformanceImageDigest string `yaml:"-" json:"conformanceImageDigest,omitempty"`
+	BusyboxImageDigest     string `yaml:"-" json:"busyboxImageDigest,omitempty"`
+
+	// ConformanceImagePlatformDigests and BusyboxImagePlatformDigests are the
+	// digests of the platform manifests, if the images were resolved to an index.
+	// Container runtimes report either the index or the platform digest.
+	ConformanceImagePlatformDigests []string `yaml:"-" json:"-"`
+	BusyboxImagePlatformDigests     []string `yaml:"-" json:"-"`
 }
 
 func NewDefaultConfiguration() Configuration {
 	return Configuration{
 		Parallel:               1,
+		Shards:                 1,
 		Verbosity:              4,
 		OutputDir:              ".",
 		BusyboxImage:           DefaultBusyboxImage,
 		Namespace:              DefaultNamespace,
 		StartupTimeout:         5 * time.Minute,
+		PrepullTimeout:         10 * time.Minute,
 		DisableProgressStatus:  false,
 		ProgressStatusInterval: 30 * time.Second,
+		Output:                 OutputText,
+	}
+}
+
+// VerifiesSignatures returns true if the signatures of the conformance and busybox
+// images must be verified before running them.
+func (c *Configuration) VerifiesSignatures() bool {
+	return c.SignatureKey != "" || c.SignatureTrustBundle != ""
+}
+
+// PinnedConformanceImage returns the conformance image, pinned to its digest if it was resolved.
+func (c *Configuration) PinnedConformanceImage() string {
+	return pinImage(c.ConformanceImage, c.ConformanceImageDigest)
+}
+
+// PinnedBusyboxImage returns the busybox image, pinned to its digest if it was resolved.
+func (c *Configuration) PinnedBusyboxImage() string {
+	return pinImage(c.BusyboxImage, c.BusyboxImageDigest)
+}
+
+// pinImage appends the digest to an image reference that does not contain one,
+// e.g. registry.k8s.io/pause:3.10@sha256:ee6521f2...
+func pinImage(image, digest string) string {
+	if digest == "" || strings.Contains(image, "@") {
+		return image
 	}
+
+	return image + "@" + digest
+}
+
+// AllowedNotReadyNodes returns the number of nodes the e2e suite tolerates to be
+// not ready, as set by --allowed-not-ready-nodes in the extra args.
+func (c *Configuration) AllowedNotReadyNodes() (int, error) {
+	allowed := 0
+
+	for _, arg := range c.ExtraArgs {
+		value, found := strings.CutPrefix(arg, "--allowed-not-ready-nodes=")
+		if !found {
+			continue
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("expected --allowed-not-ready-nodes to be a non-negative integer, got %q", value)
+		}
+
+		// like any flag, the last occurrence wins
+		allowed = n
+	}
+
+	return allowed, nil
 }
 
 func (c *Configuration) Validate() error {
@@ -73,10 +159,38 @@ func (c *Configuration) Validate() error {
 		return fmt.Errorf("invalid --extra-args: %w", err)
 	}
 
+	if _, err := c.AllowedNotReadyNodes(); err != nil {
+		return fmt.Errorf("invalid --extra-args: %w", err)
+	}
+
 	if err := validateArgsFlag(c.ExtraGinkgoArgs); err != nil {
 		return fmt.Errorf("invalid --extra-ginkgo-args: %w", err)
 	}
 
+	switch c.Output {
+	case "", OutputText, OutputJSON, OutputYAML:
+	case OutputRepoList:
+		if c.MirrorRegistry == "" {
+			return fmt.Errorf("--output %s requires --mirror-registry", OutputRepoList)
+		}
+	default:
+		return fmt.Errorf("invalid --output %q, must be one of: %s, %s, %s, %s", c.Output, OutputText, OutputJSON, OutputYAML, OutputRepoList)
+	}
+
+	if c.SignatureKey != "" && c.SignatureTrustBundle != "" {
+		return errors.New("--signature-key and --signature-trust-bundle cannot be combined")
+	}
+
+	if c.SignatureTrustBundle != "" && c.SignatureIdentity == "" {
+		return errors.New("--signature-trust-bundle requires --signature-identity")
+	}
+
+	for i := range c.KnownFailures {
+		if err := c.KnownFailures[i].Validate(); err != nil {
+			return fmt.Errorf("invalid knownFailures: %w", err)
+		}
+	}
+
 	if c.Parallel > 1 {
 		for _, arg := range c.ExtraGinkgoArgs {
 			if strings.Contains(arg, "--nodes=") || strings.Contains(arg, "--procs=") {
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateExtraArgs(t *testing.T) {
@@ -48,6 +49,11 @@ func TestValidateExtraArgs(t *testing.T) {
 			extraArgs:   []string{"key1=value1", "--key2=value2"},
 			expectedErr: "invalid --extra-args: expected key [key1] in [key1=value1] to start with prefix --",
 		},
+		{
+			name:        "invalid: allowed not ready nodes",
+			extraArgs:   []string{"--allowed-not-ready-nodes=two"},
+			expectedErr: `invalid --extra-args: expected --allowed-not-ready-nodes to be a non-negative integer, got "two"`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -65,3 +71,103 @@ func TestValidateExtraArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestAllowedNotReadyNodes(t *testing.T) {
+	config := Configuration{}
+	allowed, err := config.AllowedNotReadyNodes()
+	require.NoError(t, err)
+	assert.Equal(t, 0, allowed)
+
+	config.ExtraArgs = []string{"--allowed-not-ready-nodes=1", "--clean-start=true", "--allowed-not-ready-nodes=2"}
+	allowed, err = config.AllowedNotReadyNodes()
+	require.NoError(t, err)
+	assert.Equal(t, 2, allowed)
+
+	config.ExtraArgs = []string{"--allowed-not-ready-nodes=-1"}
+	_, err = config.AllowedNotReadyNodes()
+	assert.Error(t, err)
+}
+
+func TestValidateOutput(t *testing.T) {
+	testCases := []struct {
+		name           string
+		output         string
+		mirrorRegistry string
+		expectedErr    string
+	}{
+		{
+			name:   "default",
+			output: "",
+		},
+		{
+			name:   "text",
+			output: OutputText,
+		},
+		{
+			name:   "json",
+			output: OutputJSON,
+		},
+		{
+			name:   "yaml",
+			output: OutputYAML,
+		},
+		{
+			name:           "repo list",
+			output:         OutputRepoList,
+			mirrorRegistry: "mirror.example.com",
+		},
+		{
+			name:        "repo list without mirror",
+			output:      OutputRepoList,
+			expectedErr: "--output repo-list requires --mirror-registry",
+		},
+		{
+			name:        "unknown format",
+			output:      "xml",
+			expectedErr: `invalid --output "xml", must be one of: text, json, yaml, repo-list`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := Configuration{
+				Output:         tc.output,
+				MirrorRegistry: tc.mirrorRegistry,
+			}
+
+			err := config.Validate()
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestPinnedImages(t *testing.T) {
+	config := Configuration{
+		ConformanceImage:       "registry.k8s.io/conformance:v1.35.1",
+		ConformanceImageDigest: "sha256:aaaa",
+		BusyboxImage:           DefaultBusyboxImage,
+	}
+
+	assert.Equal(t, "registry.k8s.io/conformance:v1.35.1@sha256:aaaa", config.PinnedConformanceImage())
+	assert.Equal(t, DefaultBusyboxImage, config.PinnedBusyboxImage())
+
+	// references with a digest are used as given
+	config.ConformanceImage = "registry.k8s.io/conformance@sha256:aaaa"
+	assert.Equal(t, "registry.k8s.io/conformance@sha256:aaaa", config.PinnedConformanceImage())
+}
+
+func TestValidateSignature(t *testing.T) {
+	config := Configuration{SignatureKey: "cosign.pub", SignatureTrustBundle: "sigstore.pem"}
+	assert.EqualError(t, config.Validate(), "--signature-key and --signature-trust-bundle cannot be combined")
+
+	config = Configuration{SignatureTrustBundle: "sigstore.pem"}
+	assert.EqualError(t, config.Validate(), "--signature-trust-bundle requires --signature-identity")
+
+	config.SignatureIdentity = "krel-trust@k8s-releng-prod.iam.gserviceaccount.com"
+	assert.NoError(t, config.Validate())
+	assert.True(t, config.VerifiesSignatures())
+}
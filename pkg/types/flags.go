@@ -29,7 +29,8 @@ import (
 func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&c.configFile, "config", "c", "", "path to an optional base configuration file.")
 	fs.StringVar(&c.Kubeconfig, "kubeconfig", c.Kubeconfig, "path to the kubeconfig file.")
-	fs.IntVarP(&c.Parallel, "parallel", "p", c.Parallel, "number of parallel threads in test framework (automatically sets the --nodes Ginkgo flag).")
+	fs.IntVarP(&c.Parallel, "parallel", "p", c.Parallel, "number of parallel threads in test framework (automatically sets the --nodes Ginkgo flag). [Serial] tests run afterwards in a separate phase.")
+	fs.IntVar(&c.Shards, "shards", c.Shards, "number of conformance pods to distribute the tests across. [Serial] tests run afterwards in a separate phase.")
 	fs.IntVarP(&c.Verbosity, "verbosity", "v", c.Verbosity, "verbosity of test framework (values >= 6 automatically sets the -v Ginkgo flag).")
 	fs.StringVarP(&c.OutputDir, "output-dir", "o", c.OutputDir, "directory for logs.")
 	fs.StringVar(&c.Skip, "skip", c.Skip, "skip specific tests. allows regular expressions.")
@@ -39,11 +40,22 @@ func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&c.DryRun, "dry-run", c.DryRun, "run in dry run mode.")
 	fs.StringVar(&c.TestRepoList, "test-repo-list", c.TestRepoList, "yaml file to override registries for test images.")
 	fs.StringVar(&c.TestRepo, "test-repo", c.TestRepo, "registry for pulling Kubernetes test images.")
+	fs.BoolVar(&c.RequireDigest, "require-digest", c.RequireDigest, "refuse to run the conformance and busybox images by tag if they cannot be resolved to a digest.")
+	fs.StringVar(&c.SignatureKey, "signature-key", c.SignatureKey, "PEM file with the public key the conformance and busybox images must be signed with (cosign-compatible).")
+	fs.StringVar(&c.SignatureTrustBundle, "signature-trust-bundle", c.SignatureTrustBundle, "PEM file with the CA certificates and transparency log keys to verify keyless cosign signatures of the conformance and busybox images offline.")
+	fs.StringVar(&c.SignatureIdentity, "signature-identity", c.SignatureIdentity, "email or URI the signing certificates must be issued for, required with --signature-trust-bundle.")
+	fs.StringVar(&c.SignatureIssuer, "signature-issuer", c.SignatureIssuer, "OIDC issuer the signing certificates must be issued by, e.g. https://accounts.google.com.")
+	fs.StringVar(&c.MirrorRegistry, "mirror-registry", c.MirrorRegistry, "registry prefix replacing the registries of all test images in --output repo-list and of the default conformance image, e.g. mirror.example.com/k8s.")
 	fs.DurationVar(&c.StartupTimeout, "startup-timeout", c.StartupTimeout, "max time to wait for the conformance test pod to start up.")
+	fs.BoolVar(&c.PreflightImages, "preflight-images", c.PreflightImages, "check that all images exist in their registries for every node platform before deploying the tests.")
+	fs.BoolVar(&c.StrictVersion, "strict-version", c.StrictVersion, "fail instead of warning if the kubelets, kube-proxies or conformance image have an unsupported version skew to the API server.")
+	fs.BoolVar(&c.PrepullImages, "prepull-images", c.PrepullImages, "pull all test images on every node before the tests start.")
+	fs.DurationVar(&c.PrepullTimeout, "prepull-timeout", c.PrepullTimeout, "max time to wait for all nodes to pull the test images.")
 	fs.StringSliceVar(&c.ExtraArgs, "extra-args", c.ExtraArgs, "Additional parameters to be provided to the conformance container. These parameters should be specified as key-value pairs, separated by commas. Each parameter should start with -- (e.g., --clean-start=true,--allowed-not-ready-nodes=2)")
 	fs.StringSliceVar(&c.ExtraGinkgoArgs, "extra-ginkgo-args", c.ExtraGinkgoArgs, "Additional parameters to be provided to Ginkgo runner. This flag has the same format as --extra-args.")
 	fs.BoolVar(&c.DisableProgressStatus, "disable-progress-status", c.DisableProgressStatus, "disable the periodic progress status updates during test execution.")
 	fs.DurationVar(&c.ProgressStatusInterval, "progress-status-interval", c.ProgressStatusInterval, "interval duration for progress status updates")
+	fs.StringVar(&c.Output, "output", c.Output, "format of the results summary printed at the end of a run or of the list of tests or images: text, json, yaml or repo-list (printed to stdout).")
 }
 
 func (c *Configuration) Complete(fs *pflag.FlagSet) (*Configuration, error) {
@@ -67,10 +79,18 @@ func (c *Configuration) Complete(fs *pflag.FlagSet) (*Configuration, error) {
 		c.StartupTimeout = defaults.StartupTimeout
 	}
 
+	if c.PrepullTimeout == 0 {
+		c.PrepullTimeout = defaults.PrepullTimeout
+	}
+
 	if c.Parallel <= 0 {
 		return nil, errors.New("--parallel cannot be less than 1")
 	}
 
+	if c.Shards <= 0 {
+		return nil, errors.New("--shards cannot be less than 1")
+	}
+
 	if c.Verbosity <= 0 {
 		return nil, errors.New("--verbosity cannot be less than 1")
 	}
@@ -127,6 +147,7 @@ type changeDetector func(flag string) bool
 func mergeConfigs(changed changeDetector, fromFlags, loaded *Configuration) *Configuration {
 	overwrite(changed, "kubeconfig", &loaded.Kubeconfig, fromFlags.Kubeconfig)
 	overwrite(changed, "parallel", &loaded.Parallel, fromFlags.Parallel)
+	overwrite(changed, "shards", &loaded.Shards, fromFlags.Shards)
 	overwrite(changed, "verbosity", &loaded.Verbosity, fromFlags.Verbosity)
 	overwrite(changed, "output-dir", &loaded.OutputDir, fromFlags.OutputDir)
 	overwrite(changed, "skip", &loaded.Skip, fromFlags.Skip)
@@ -135,12 +156,23 @@ func mergeConfigs(changed changeDetector, fromFlags, loaded *Configuration) *Con
 	overwrite(changed, "namespace", &loaded.Namespace, fromFlags.Namespace)
 	overwrite(changed, "dry-run", &loaded.DryRun, fromFlags.DryRun)
 	overwrite(changed, "startup-timeout", &loaded.StartupTimeout, fromFlags.StartupTimeout)
+	overwrite(changed, "preflight-images", &loaded.PreflightImages, fromFlags.PreflightImages)
+	overwrite(changed, "strict-version", &loaded.StrictVersion, fromFlags.StrictVersion)
+	overwrite(changed, "prepull-images", &loaded.PrepullImages, fromFlags.PrepullImages)
+	overwrite(changed, "prepull-timeout", &loaded.PrepullTimeout, fromFlags.PrepullTimeout)
 	overwrite(changed, "test-repo-list", &loaded.TestRepoList, fromFlags.TestRepoList)
 	overwrite(changed, "test-repo", &loaded.TestRepo, fromFlags.TestRepo)
+	overwrite(changed, "require-digest", &loaded.RequireDigest, fromFlags.RequireDigest)
+	overwrite(changed, "signature-key", &loaded.SignatureKey, fromFlags.SignatureKey)
+	overwrite(changed, "signature-trust-bundle", &loaded.SignatureTrustBundle, fromFlags.SignatureTrustBundle)
+	overwrite(changed, "signature-identity", &loaded.SignatureIdentity, fromFlags.SignatureIdentity)
+	overwrite(changed, "signature-issuer", &loaded.SignatureIssuer, fromFlags.SignatureIssuer)
+	overwrite(changed, "mirror-registry", &loaded.MirrorRegistry, fromFlags.MirrorRegistry)
 	overwriteSlice(changed, "extra-args", &loaded.ExtraArgs, fromFlags.ExtraArgs)
 	overwriteSlice(changed, "extra-ginkgo-args", &loaded.ExtraGinkgoArgs, fromFlags.ExtraGinkgoArgs)
 	overwrite(changed, "disable-progress-status", &loaded.DisableProgressStatus, fromFlags.DisableProgressStatus)
 	overwrite(changed, "progress-status-interval", &loaded.ProgressStatusInterval, fromFlags.ProgressStatusInterval)
+	overwrite(changed, "output", &loaded.Output, fromFlags.Output)
 
 	return loaded
 }
@@ -158,7 +190,9 @@ func overwriteSlice[T comparable](changed changeDetector, flagName string, dst *
 	}
 }
 
-func resolveKubeconfig(kubeconfig string) (string, error) {
+// ResolveKubeconfig returns the path of the kubeconfig file to use, falling back to
+// $KUBECONFIG and ~/.kube/config.
+func ResolveKubeconfig(kubeconfig string) (string, error) {
 	if kubeconfig == "" {
 		if envvar := os.Getenv("KUBECONFIG"); envvar != "" {
 			kubeconfig = envvar
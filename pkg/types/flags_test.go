@@ -36,7 +36,7 @@ func TestResolveKubeconfig(t *testing.T) {
 	kubeconfig := ""
 	expected := filepath.Join(homeDir, ".kube", "config")
 
-	actual, err := resolveKubeconfig(kubeconfig)
+	actual, err := ResolveKubeconfig(kubeconfig)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 
@@ -45,7 +45,7 @@ func TestResolveKubeconfig(t *testing.T) {
 	os.Setenv("KUBECONFIG", kubeconfig)
 	expected = kubeconfig
 
-	actual, err = resolveKubeconfig("")
+	actual, err = ResolveKubeconfig("")
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 
@@ -53,7 +53,7 @@ func TestResolveKubeconfig(t *testing.T) {
 	kubeconfig = "~/custom/kubeconfig"
 	expected = filepath.Join(homeDir, "custom", "kubeconfig")
 
-	actual, err = resolveKubeconfig(kubeconfig)
+	actual, err = ResolveKubeconfig(kubeconfig)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
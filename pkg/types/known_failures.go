@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// expiryFormat is the date format of KnownFailure.Expires.
+const expiryFormat = time.DateOnly
+
+// KnownFailure is a documented and accepted failure of one or more specs.
+type KnownFailure struct {
+	// Spec is a regular expression matching the names of the affected specs.
+	Spec string `yaml:"spec" json:"spec"`
+	// Reason documents why the failure is accepted.
+	Reason string `yaml:"reason" json:"reason"`
+	// Expires is an optional date (YYYY-MM-DD); after this day the failure is no longer accepted.
+	Expires string `yaml:"expires,omitempty" json:"expires,omitempty"`
+
+	// spec is the compiled Spec, set by Validate.
+	spec *regexp.Regexp
+}
+
+// Matches returns true if the known failure applies to the spec with the given name.
+func (k *KnownFailure) Matches(name string) bool {
+	re := k.spec
+	if re == nil {
+		var err error
+		if re, err = regexp.Compile(k.Spec); err != nil {
+			return false
+		}
+	}
+
+	return re.MatchString(name)
+}
+
+// Expired returns true if the known failure has an expiry date that is in the past.
+func (k *KnownFailure) Expired(now time.Time) bool {
+	if k.Expires == "" {
+		return false
+	}
+
+	expires, err := time.Parse(expiryFormat, k.Expires)
+	if err != nil {
+		return false
+	}
+
+	// the failure is accepted until the end of the given day
+	return !now.UTC().Before(expires.AddDate(0, 0, 1))
+}
+
+// Validate checks that the known failure can be applied.
+func (k *KnownFailure) Validate() error {
+	if k.Spec == "" {
+		return errors.New("spec must not be empty")
+	}
+
+	re, err := regexp.Compile(k.Spec)
+	if err != nil {
+		return fmt.Errorf("invalid spec %q: %w", k.Spec, err)
+	}
+
+	k.spec = re
+
+	if k.Reason == "" {
+		return fmt.Errorf("missing reason for spec %q", k.Spec)
+	}
+
+	if k.Expires != "" {
+		if _, err := time.Parse(expiryFormat, k.Expires); err != nil {
+			return fmt.Errorf("invalid expiry date %q for spec %q, must be YYYY-MM-DD", k.Expires, k.Spec)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKnownFailureValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		known       KnownFailure
+		expectedErr string
+	}{
+		{
+			name:  "valid",
+			known: KnownFailure{Spec: `\[sig-network\].*LoadBalancer`, Reason: "no load balancer", Expires: "2026-12-31"},
+		},
+		{
+			name:  "without expiry",
+			known: KnownFailure{Spec: "foo", Reason: "bar"},
+		},
+		{
+			name:        "empty spec",
+			known:       KnownFailure{Reason: "bar"},
+			expectedErr: "spec must not be empty",
+		},
+		{
+			name:        "invalid regex",
+			known:       KnownFailure{Spec: "[", Reason: "bar"},
+			expectedErr: "invalid spec \"[\": error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name:        "missing reason",
+			known:       KnownFailure{Spec: "foo"},
+			expectedErr: `missing reason for spec "foo"`,
+		},
+		{
+			name:        "invalid expiry",
+			known:       KnownFailure{Spec: "foo", Reason: "bar", Expires: "31.12.2026"},
+			expectedErr: `invalid expiry date "31.12.2026" for spec "foo", must be YYYY-MM-DD`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.known.Validate()
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.Nil(t, err)
+				assert.NotNil(t, tc.known.spec, "the spec is compiled once")
+			}
+		})
+	}
+}
+
+func TestKnownFailureExpired(t *testing.T) {
+	known := KnownFailure{Spec: "foo", Reason: "bar", Expires: "2026-06-30"}
+
+	assert.False(t, known.Expired(time.Date(2026, 6, 30, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, known.Expired(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, (&KnownFailure{Spec: "foo"}).Expired(time.Now()))
+}